@@ -0,0 +1,210 @@
+// Package bridge runs node plugins written in other languages in a child
+// process and exposes them as NodeExecutors.
+//
+// The child reads requests on stdin and writes responses on stdout. Every
+// message is a JSON object preceded by its length as a 4-byte big-endian
+// unsigned integer:
+//
+//	{"id": 1, "method": "list"}
+//	{"id": 1, "result": [{"node_type": "dict.get", "category": "dict", "description": "..."}]}
+//
+//	{"id": 2, "method": "execute", "params": {"node_type": "dict.get", "inputs": {...}, "store": {...}}}
+//	{"id": 2, "result": {"outputs": {...}, "store": {...}}}
+//
+//	{"id": 3, "method": "ping"}
+//	{"id": 3, "result": "pong"}
+//
+// A response carries either "result" or "error" (a message string). Calls
+// are sent one at a time; the child's stderr is passed through for logging.
+package bridge
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// maxMessageBytes limits the size of a single protocol message.
+const maxMessageBytes = 64 << 20
+
+// Caller sends requests to a plugin host.
+// It is implemented by Process and Supervisor.
+type Caller interface {
+	Call(method string, params, result interface{}) error
+}
+
+// Process is a running plugin host.
+type Process struct {
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+	nextID int64
+	err    error // set once the process has failed; later calls return it
+}
+
+// Start launches cmd as a plugin host. cmd must not have Stdin or Stdout
+// set; Stderr defaults to os.Stderr.
+func Start(cmd *exec.Cmd) (*Process, error) {
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Stderr == nil {
+		cmd.Stderr = os.Stderr
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("bridge: start %s: %w", cmd.Path, err)
+	}
+	return &Process{cmd: cmd, stdin: stdin, stdout: bufio.NewReader(stdout)}, nil
+}
+
+// request is a protocol request.
+type request struct {
+	ID     int64       `json:"id"`
+	Method string      `json:"method"`
+	Params interface{} `json:"params,omitempty"`
+}
+
+// response is a protocol response.
+type response struct {
+	ID     int64           `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+// Call sends one request and decodes its result into result.
+// An error from the host is returned as an error; transport failures also
+// mark the process as failed.
+func (p *Process) Call(method string, params, result interface{}) error {
+	return p.CallTimeout(method, params, result, 0)
+}
+
+// CallTimeout is like Call, but kills the process if no response arrives
+// within timeout. A timeout of zero means no limit.
+func (p *Process) CallTimeout(method string, params, result interface{}, timeout time.Duration) (err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+
+	if timeout > 0 {
+		var timedOut atomic.Bool
+		timer := time.AfterFunc(timeout, func() {
+			timedOut.Store(true)
+			p.cmd.Process.Kill()
+		})
+		defer func() {
+			timer.Stop()
+			if timedOut.Load() {
+				p.err = fmt.Errorf("bridge: %s call timed out after %s", method, timeout)
+				err = p.err
+			}
+		}()
+	}
+
+	p.nextID++
+	id := p.nextID
+	if err := writeMessage(p.stdin, request{ID: id, Method: method, Params: params}); err != nil {
+		return p.fail(err)
+	}
+
+	var resp response
+	if err := readMessage(p.stdout, &resp); err != nil {
+		return p.fail(err)
+	}
+	if resp.ID != id {
+		return p.fail(fmt.Errorf("response id %d does not match request id %d", resp.ID, id))
+	}
+	if resp.Error != "" {
+		return &RemoteError{Message: resp.Error}
+	}
+	if result == nil || len(resp.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+// Failed reports whether the process can no longer be used.
+func (p *Process) Failed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err != nil
+}
+
+// RemoteError is an error reported by the host for a single call.
+// The process stays usable after it.
+type RemoteError struct {
+	Message string
+}
+
+func (e *RemoteError) Error() string {
+	return e.Message
+}
+
+// fail records a transport error. The caller must hold p.mu.
+func (p *Process) fail(err error) error {
+	p.err = fmt.Errorf("bridge: %w", err)
+	return p.err
+}
+
+// Close stops the host by closing its stdin and waits for it to exit.
+func (p *Process) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err == nil {
+		p.err = errors.New("bridge: process closed")
+	}
+	p.stdin.Close()
+	return p.cmd.Wait()
+}
+
+// writeMessage writes v as a length-prefixed JSON message.
+func writeMessage(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(data)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// readMessage reads one length-prefixed JSON message into v.
+func readMessage(r io.Reader, v interface{}) error {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("plugin host exited")
+		}
+		return err
+	}
+	n := binary.BigEndian.Uint32(header[:])
+	if n > maxMessageBytes {
+		return fmt.Errorf("message of %d bytes exceeds limit", n)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
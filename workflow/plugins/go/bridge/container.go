@@ -0,0 +1,179 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// ContainerOptions configures container-isolated execution. Zero values
+// select the defaults.
+type ContainerOptions struct {
+	// Runtime is the container CLI, "docker" by default. Any CLI accepting
+	// docker run flags works, such as podman or nerdctl.
+	Runtime string
+
+	// Image must run the metabuilder command as its entrypoint, e.g. an image
+	// built from this module's Dockerfile. Required.
+	Image string
+
+	Memory    string        // memory limit, default "256m"
+	CPUs      string        // CPU limit, default "0.5"
+	PidsLimit int           // process limit, default 64
+	Network   string        // network mode, default "none"
+	Timeout   time.Duration // limit per call including container start, default 30s
+
+	// ExtraArgs are added to "docker run" before the image name.
+	ExtraArgs []string
+}
+
+// ContainerNode runs a node in a new container for every call. The container
+// gets the inputs on stdin and writes the outputs to stdout; it never sees
+// the host runtime, so isolated nodes always start with an empty store.
+type ContainerNode struct {
+	NodeType    string
+	Category    string
+	Description string
+
+	opts ContainerOptions
+}
+
+// NewContainerNode creates a container-isolated executor for nodeType.
+func NewContainerNode(nodeType string, opts ContainerOptions) *ContainerNode {
+	if opts.Runtime == "" {
+		opts.Runtime = "docker"
+	}
+	if opts.Memory == "" {
+		opts.Memory = "256m"
+	}
+	if opts.CPUs == "" {
+		opts.CPUs = "0.5"
+	}
+	if opts.PidsLimit == 0 {
+		opts.PidsLimit = 64
+	}
+	if opts.Network == "" {
+		opts.Network = "none"
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = 30 * time.Second
+	}
+	return &ContainerNode{NodeType: nodeType, opts: opts}
+}
+
+// Isolate replaces the executors of the given registered nodes with
+// container-isolated ones. Metadata and schemas are kept.
+func Isolate(reg *registry.Registry, opts ContainerOptions, nodeTypes ...string) error {
+	if opts.Image == "" {
+		return fmt.Errorf("bridge: container image is required")
+	}
+	for _, nodeType := range nodeTypes {
+		node, ok := reg.Get(nodeType)
+		if !ok {
+			return fmt.Errorf("bridge: unknown node type %q", nodeType)
+		}
+		isolated := NewContainerNode(nodeType, opts)
+		isolated.Category = node.Category
+		isolated.Description = node.Description
+		if err := reg.Wrap(nodeType, func(plugin.NodeExecutor) plugin.NodeExecutor { return isolated }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Args returns the container CLI arguments for one call, naming the
+// container name when it is not empty.
+func (n *ContainerNode) Args(name string) []string {
+	args := []string{"run", "--rm", "-i"}
+	if name != "" {
+		args = append(args, "--name", name)
+	}
+	args = append(args,
+		"--network", n.opts.Network,
+		"--memory", n.opts.Memory,
+		"--memory-swap", n.opts.Memory,
+		"--cpus", n.opts.CPUs,
+		"--pids-limit", strconv.Itoa(n.opts.PidsLimit),
+		"--read-only",
+		"--cap-drop", "ALL",
+		"--security-opt", "no-new-privileges",
+	)
+	args = append(args, n.opts.ExtraArgs...)
+	return append(args, n.opts.Image, "run-node", n.NodeType, "--inputs", "-")
+}
+
+// Execute runs the node in a new container.
+func (n *ContainerNode) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	if inputs == nil {
+		inputs = map[string]interface{}{}
+	}
+	data, err := json.Marshal(inputs)
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("encoding inputs: %v", err)}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.opts.Timeout)
+	defer cancel()
+
+	// Name the container so it can be removed on timeout: cancelling the
+	// context only kills the CLI, not the container it started
+	name, err := containerName(n.NodeType)
+	if err != nil {
+		return map[string]interface{}{"error": fmt.Sprintf("naming container: %v", err)}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, n.opts.Runtime, n.Args(name)...)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+
+	if ctx.Err() == context.DeadlineExceeded {
+		n.remove(name)
+		return map[string]interface{}{"error": fmt.Sprintf("isolated %s timed out after %s", n.NodeType, n.opts.Timeout)}
+	}
+
+	// run-node prints the outputs and exits non-zero when they contain an
+	// error, so decode stdout before looking at the exit status
+	var outputs map[string]interface{}
+	if err := json.Unmarshal(stdout.Bytes(), &outputs); err == nil && outputs != nil {
+		return outputs
+	}
+	if runErr == nil {
+		runErr = fmt.Errorf("no output")
+	}
+	msg := strings.TrimSpace(stderr.String())
+	if msg == "" {
+		msg = runErr.Error()
+	}
+	return map[string]interface{}{"error": fmt.Sprintf("isolated %s failed: %s", n.NodeType, msg)}
+}
+
+// remove force-removes the named container, which also stops it. Errors are
+// ignored: the container may never have been created.
+func (n *ContainerNode) remove(name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_ = exec.CommandContext(ctx, n.opts.Runtime, "rm", "-f", name).Run()
+}
+
+// containerName returns a unique container name for a call to nodeType.
+func containerName(nodeType string) (string, error) {
+	var b [6]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return "metabuilder-" + strings.ReplaceAll(nodeType, ".", "-") + "-" + hex.EncodeToString(b[:]), nil
+}
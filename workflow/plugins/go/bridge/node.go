@@ -0,0 +1,94 @@
+package bridge
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// Runtime is the store access a bridged node needs from its runtime.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// Node is a plugin implemented by a host process.
+type Node struct {
+	NodeType    string
+	Category    string
+	Description string
+
+	// remoteType is the node type known to the host, without any prefix.
+	remoteType string
+	host       Caller
+}
+
+// Execute sends the inputs and the runtime's store to the host. Store
+// changes made by the remote plugin are copied back into the store.
+func (n *Node) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	var store map[string]interface{}
+	if r, ok := runtime.(Runtime); ok {
+		store = r.GetStore()
+	} else if r, ok := runtime.(map[string]interface{}); ok {
+		store, _ = r["Store"].(map[string]interface{})
+	}
+
+	params := map[string]interface{}{
+		"node_type": n.remoteType,
+		"inputs":    inputs,
+		"store":     store,
+	}
+	var result struct {
+		Outputs map[string]interface{} `json:"outputs"`
+		Store   map[string]interface{} `json:"store"`
+	}
+	if err := n.host.Call("execute", params, &result); err != nil {
+		return map[string]interface{}{"error": err.Error()}
+	}
+
+	if store != nil && result.Store != nil {
+		for key := range store {
+			if _, ok := result.Store[key]; !ok {
+				delete(store, key)
+			}
+		}
+		for key, value := range result.Store {
+			store[key] = value
+		}
+	}
+	if result.Outputs == nil {
+		result.Outputs = map[string]interface{}{}
+	}
+	return result.Outputs
+}
+
+// nodeInfo is one entry of the host's "list" result.
+type nodeInfo struct {
+	NodeType    string `json:"node_type"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+}
+
+// Register asks the host for its plugins and registers each one in reg.
+// prefix is prepended to every node type, e.g. "python." registers the
+// host's dict.get as python.dict.get, so bridged nodes never clash with
+// native ones.
+func Register(reg *registry.Registry, host Caller, prefix string) ([]*registry.Node, error) {
+	var infos []nodeInfo
+	if err := host.Call("list", nil, &infos); err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*registry.Node, 0, len(infos))
+	for _, info := range infos {
+		node, err := reg.Register(&Node{
+			NodeType:    prefix + info.NodeType,
+			Category:    info.Category,
+			Description: info.Description,
+			remoteType:  info.NodeType,
+			host:        host,
+		}, registry.Schema{})
+		if err != nil {
+			return nodes, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
@@ -0,0 +1,36 @@
+package bridge
+
+import (
+	"os/exec"
+	"path/filepath"
+
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// NodeJSCommand returns a function creating the command that runs the
+// Node.js plugin host, workflow/plugins/ts/bridge.js, from the repository
+// root repoRoot. node is the executable to use, "node" if empty. modules
+// lists compiled plugin modules to load; by default the host loads every
+// <category>/src/index.js of workflow/plugins/ts.
+func NodeJSCommand(node, repoRoot string, modules ...string) func() *exec.Cmd {
+	if node == "" {
+		node = "node"
+	}
+	script := filepath.Join("workflow", "plugins", "ts", "bridge.js")
+	return func() *exec.Cmd {
+		cmd := exec.Command(node, append([]string{script}, modules...)...)
+		cmd.Dir = repoRoot
+		return cmd
+	}
+}
+
+// StartNodeJS starts a supervised Node.js plugin host and registers its
+// plugins under the "js." prefix.
+func StartNodeJS(reg *registry.Registry, newCmd func() *exec.Cmd, opts SupervisorOptions) (*Supervisor, error) {
+	s := NewSupervisor(newCmd, opts)
+	if _, err := Register(reg, s, "js."); err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,33 @@
+package bridge
+
+import (
+	"os/exec"
+
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// PythonCommand returns the command that runs the Python plugin host,
+// workflow/plugins/python/bridge.py, from the repository root repoRoot.
+// python is the interpreter to use, "python3" if empty.
+func PythonCommand(python, repoRoot string) *exec.Cmd {
+	if python == "" {
+		python = "python3"
+	}
+	cmd := exec.Command(python, "-m", "workflow.plugins.python.bridge")
+	cmd.Dir = repoRoot
+	return cmd
+}
+
+// StartPython starts the Python plugin host and registers its plugins under
+// the "python." prefix.
+func StartPython(reg *registry.Registry, python, repoRoot string) (*Process, error) {
+	p, err := Start(PythonCommand(python, repoRoot))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := Register(reg, p, "python."); err != nil {
+		p.Close()
+		return nil, err
+	}
+	return p, nil
+}
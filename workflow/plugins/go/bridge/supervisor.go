@@ -0,0 +1,188 @@
+package bridge
+
+import (
+	"errors"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// SupervisorOptions configures a Supervisor. Zero values select the defaults.
+type SupervisorOptions struct {
+	// CallTimeout limits every call; a host that does not answer in time is
+	// killed and restarted. Default 30s.
+	CallTimeout time.Duration
+
+	// HealthInterval is the time between "ping" health checks of an idle
+	// host. Default 10s; negative disables health checks.
+	HealthInterval time.Duration
+
+	// RestartDelay is the minimum time between two starts of the host,
+	// so a host that crashes on startup is not restarted in a tight loop.
+	// Default 1s.
+	RestartDelay time.Duration
+}
+
+// Supervisor keeps a plugin host running. The host is started on first use
+// and restarted after it crashes, stops answering health checks or exceeds
+// the call timeout. Calls that were in flight when the host died fail; the
+// next call starts a new host.
+type Supervisor struct {
+	newCmd func() *exec.Cmd
+	opts   SupervisorOptions
+
+	mu        sync.Mutex
+	proc      *Process
+	lastStart time.Time
+	starting  chan struct{} // closed when the host being started is ready
+	restarts  int
+	closed    bool
+	done      chan struct{}
+}
+
+// NewSupervisor creates a supervisor that starts hosts with newCmd.
+// newCmd must return a new, unstarted command on every call.
+func NewSupervisor(newCmd func() *exec.Cmd, opts SupervisorOptions) *Supervisor {
+	if opts.CallTimeout == 0 {
+		opts.CallTimeout = 30 * time.Second
+	}
+	if opts.HealthInterval == 0 {
+		opts.HealthInterval = 10 * time.Second
+	}
+	if opts.RestartDelay == 0 {
+		opts.RestartDelay = time.Second
+	}
+
+	s := &Supervisor{newCmd: newCmd, opts: opts, done: make(chan struct{})}
+	if opts.HealthInterval > 0 {
+		go s.healthCheck()
+	}
+	return s
+}
+
+// Call sends a request to the host, starting it if needed.
+func (s *Supervisor) Call(method string, params, result interface{}) error {
+	proc, err := s.process()
+	if err != nil {
+		return err
+	}
+	return proc.CallTimeout(method, params, result, s.opts.CallTimeout)
+}
+
+// Restarts returns how many times the host has been restarted.
+func (s *Supervisor) Restarts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.restarts
+}
+
+// Close stops health checks and the host.
+func (s *Supervisor) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	close(s.done)
+	if s.proc == nil {
+		return nil
+	}
+	err := s.proc.Close()
+	s.proc = nil
+	return err
+}
+
+// process returns a running host, replacing a failed one. The lock is not
+// held while waiting out the restart delay or starting the host, so other
+// callers wait for that start instead of blocking on the mutex.
+func (s *Supervisor) process() (*Process, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for {
+		if s.closed {
+			return nil, errors.New("bridge: supervisor closed")
+		}
+		if s.proc != nil && !s.proc.Failed() {
+			return s.proc, nil
+		}
+		if s.starting == nil {
+			break
+		}
+		// Another call is starting the host; check again once it is done
+		starting := s.starting
+		s.mu.Unlock()
+		<-starting
+		s.mu.Lock()
+	}
+
+	if s.proc != nil {
+		// The old host already failed; reap it and ignore its exit status
+		s.proc.cmd.Process.Kill()
+		s.proc.Close()
+		s.proc = nil
+		s.restarts++
+	}
+	wait := s.opts.RestartDelay - time.Since(s.lastStart)
+	starting := make(chan struct{})
+	s.starting = starting
+	s.mu.Unlock()
+
+	var proc *Process
+	err := errors.New("bridge: supervisor closed")
+	started := time.Now()
+	select {
+	case <-s.done:
+	case <-time.After(wait):
+		started = time.Now()
+		proc, err = Start(s.newCmd())
+	}
+
+	s.mu.Lock()
+	s.starting = nil
+	close(starting)
+	s.lastStart = started
+	if err != nil {
+		return nil, err
+	}
+	if s.closed {
+		// Closed while starting; do not leave the new host running
+		proc.Close()
+		return nil, errors.New("bridge: supervisor closed")
+	}
+	s.proc = proc
+	return proc, nil
+}
+
+// healthCheck pings a running host every HealthInterval. A host that does
+// not answer within the call timeout is killed, so the next call restarts it.
+func (s *Supervisor) healthCheck() {
+	ticker := time.NewTicker(s.opts.HealthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+
+		s.mu.Lock()
+		proc := s.proc
+		s.mu.Unlock()
+		if proc == nil || proc.Failed() {
+			continue
+		}
+		var pong string
+		if err := proc.CallTimeout("ping", nil, &pong, s.opts.CallTimeout); err != nil {
+			var remote *RemoteError
+			if errors.As(err, &remote) {
+				// The host answered, so it is alive even if it has no ping method
+				continue
+			}
+			proc.cmd.Process.Kill()
+		}
+	}
+}
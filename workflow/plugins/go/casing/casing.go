@@ -0,0 +1,87 @@
+// Package casing splits identifiers into words and joins them in the naming
+// styles used across services: snake_case, camelCase, kebab-case,
+// PascalCase, CONSTANT_CASE and Title Case.
+package casing
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Styles lists the names accepted by Convert.
+var Styles = []string{"snake", "camel", "kebab", "pascal", "constant", "title"}
+
+// Words splits s into words. Separators are any characters other than
+// letters and digits; a new word also starts at a lower-to-upper case
+// change ("userId") and before the last capital of an acronym followed by
+// lowercase letters ("HTTPServer"). Digits stay with the preceding word.
+func Words(s string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(s)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = word[:0]
+		}
+	}
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && len(word) > 0 {
+			prev := word[len(word)-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// Convert rewrites s in the named style. It returns an error for unknown
+// styles.
+func Convert(s, style string) (string, error) {
+	words := Words(s)
+	switch style {
+	case "snake":
+		return strings.ToLower(strings.Join(words, "_")), nil
+	case "kebab":
+		return strings.ToLower(strings.Join(words, "-")), nil
+	case "constant":
+		return strings.ToUpper(strings.Join(words, "_")), nil
+	case "camel":
+		for i, w := range words {
+			if i == 0 {
+				words[i] = strings.ToLower(w)
+			} else {
+				words[i] = capitalize(w)
+			}
+		}
+		return strings.Join(words, ""), nil
+	case "pascal", "title":
+		for i, w := range words {
+			words[i] = capitalize(w)
+		}
+		if style == "title" {
+			return strings.Join(words, " "), nil
+		}
+		return strings.Join(words, ""), nil
+	default:
+		return "", fmt.Errorf("unknown case style %q (want one of %s)", style, strings.Join(Styles, ", "))
+	}
+}
+
+// capitalize upper-cases the first letter of w and lower-cases the rest.
+func capitalize(w string) string {
+	runes := []rune(strings.ToLower(w))
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
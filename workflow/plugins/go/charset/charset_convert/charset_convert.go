@@ -0,0 +1,199 @@
+// Package charset_convert provides a workflow plugin for converting text between character encodings.
+package charset_convert
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"unicode/utf8"
+)
+
+//go:generate python3 gen_sjis.py
+
+// CharsetConvert implements the NodeExecutor interface for converting encodings.
+type CharsetConvert struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewCharsetConvert creates a new CharsetConvert instance.
+func NewCharsetConvert() *CharsetConvert {
+	return &CharsetConvert{
+		NodeType:    "charset.convert",
+		Category:    "charset",
+		Description: "Convert text between character encodings",
+	}
+}
+
+// Execute runs the plugin logic.
+// Supported encodings are utf-8, utf-16 (with byte order mark), utf-16le,
+// utf-16be, ascii, iso-8859-1 (latin-1), windows-1252 (cp1252) and
+// shift_jis, which is read as Windows code page 932 (also cp932 or
+// windows-31j) like browsers do, so the NEC and IBM extensions such as
+// circled numbers are included. With from "auto" the encoding is guessed:
+// a byte order mark or valid UTF-8 is trusted, then UTF-16 is recognised
+// by its zero bytes, Shift-JIS by valid double-byte sequences, and
+// anything else is taken as windows-1252 (or iso-8859-1 when the two
+// agree).
+//
+// Data in an encoding other than UTF-8 is raw bytes, as a byte slice or a
+// string holding them, or base64 with base64_input. Likewise the result is
+// a string of raw bytes unless it is UTF-8 or base64_output is set.
+// Inputs:
+//   - data: the bytes or string to convert
+//   - from: (optional) encoding of data, or "auto" to detect it (default: "auto")
+//   - to: (optional) encoding of the result (default: "utf-8")
+//   - base64_input: (optional) data is base64-encoded (default: false)
+//   - base64_output: (optional) base64-encode the result (default: false)
+//   - strict: (optional) fail on bytes that cannot be decoded or characters that cannot be encoded, instead of replacing them with U+FFFD or "?" (default: false)
+//
+// Returns:
+//   - result: the converted text
+//   - encoding: the encoding data was read as
+//   - replaced: number of characters that could not be encoded in to and were replaced
+//   - error: set if data is missing, an encoding is unsupported or, when strict, conversion fails
+func (p *CharsetConvert) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": "", "encoding": "", "replaced": 0, "error": fmt.Sprintf(format, args...)}
+	}
+
+	var data []byte
+	switch d := inputs["data"].(type) {
+	case []byte:
+		data = d
+	case string:
+		data = []byte(d)
+	default:
+		return fail("data is required")
+	}
+	if b64, _ := inputs["base64_input"].(bool); b64 {
+		decoded, err := base64.StdEncoding.DecodeString(string(data))
+		if err != nil {
+			return fail("invalid base64 data: %v", err)
+		}
+		data = decoded
+	}
+	strict, _ := inputs["strict"].(bool)
+
+	from, _ := inputs["from"].(string)
+	if from == "" || from == "auto" {
+		from = detect(data)
+	}
+	source, err := lookup(from)
+	if err != nil {
+		return fail("%v", err)
+	}
+	to, _ := inputs["to"].(string)
+	if to == "" {
+		to = "utf-8"
+	}
+	target, err := lookup(to)
+	if err != nil {
+		return fail("%v", err)
+	}
+
+	runes, bad := source.decode(data, strict)
+	if bad >= 0 {
+		return fail("invalid %s data at byte %d", source.name, bad)
+	}
+
+	out := make([]byte, 0, len(data))
+	if target.name == "utf-16" {
+		out = append(out, 0xFE, 0xFF)
+	}
+	replaced := 0
+	for i, r := range runes {
+		var ok bool
+		if out, ok = target.encode(out, r); ok {
+			continue
+		}
+		if strict {
+			return fail("character %q at position %d cannot be encoded in %s", r, i, target.name)
+		}
+		out, _ = target.encode(out, '?')
+		replaced++
+	}
+
+	var result string
+	if b64, _ := inputs["base64_output"].(bool); b64 {
+		result = base64.StdEncoding.EncodeToString(out)
+	} else {
+		result = string(out)
+	}
+
+	return map[string]interface{}{"result": result, "encoding": source.name, "replaced": replaced}
+}
+
+// detect guesses the encoding of data, returning one of the canonical
+// encoding names.
+func detect(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		return "utf-8"
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}):
+		return "utf-16le"
+	case bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		return "utf-16be"
+	case utf8.Valid(data):
+		return "utf-8"
+	}
+
+	// Text in UTF-16 without a byte order mark has a zero byte in most
+	// ASCII characters, on the odd positions for little endian
+	if len(data)%2 == 0 {
+		var even, odd int
+		for i, b := range data {
+			if b == 0 {
+				if i%2 == 0 {
+					even++
+				} else {
+					odd++
+				}
+			}
+		}
+		half := len(data) / 2
+		switch {
+		case odd*10 >= half*3 && even == 0:
+			return "utf-16le"
+		case even*10 >= half*3 && odd == 0:
+			return "utf-16be"
+		}
+	}
+
+	if looksShiftJIS(data) {
+		return "shift_jis"
+	}
+	for _, b := range data {
+		if b >= 0x80 && b <= 0x9F {
+			return "windows-1252"
+		}
+	}
+	return "iso-8859-1"
+}
+
+// looksShiftJIS reports whether data decodes as Shift-JIS with at least one
+// double-byte character, mostly from the common lead bytes (kana and the
+// more frequent kanji) that accented Latin text rarely produces.
+func looksShiftJIS(data []byte) bool {
+	common, rare := 0, 0
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		switch {
+		case b < 0x80 || b >= 0xA1 && b <= 0xDF:
+			continue
+		case isSJISLead(b) && i+1 < len(data):
+			if _, ok := sjisPair(b, data[i+1]); ok {
+				if b <= 0x9F {
+					common++
+				} else {
+					rare++
+				}
+				i++
+				continue
+			}
+		}
+		return false
+	}
+	return common > 0 && common >= rare
+}
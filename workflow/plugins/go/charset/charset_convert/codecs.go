@@ -0,0 +1,294 @@
+package charset_convert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// codec converts between an encoding and Unicode. decode reports the
+// offset of the first invalid byte, or -1; encode reports whether r can be
+// represented.
+type codec struct {
+	name   string
+	decode func(data []byte, strict bool) ([]rune, int)
+	encode func(dst []byte, r rune) ([]byte, bool)
+}
+
+// codecs lists the supported encodings by canonical name.
+var codecs = map[string]codec{
+	"utf-8":        {"utf-8", decodeUTF8, encodeUTF8},
+	"utf-16":       {"utf-16", decodeUTF16(false, true), encodeUTF16(false)},
+	"utf-16le":     {"utf-16le", decodeUTF16(true, false), encodeUTF16(true)},
+	"utf-16be":     {"utf-16be", decodeUTF16(false, false), encodeUTF16(false)},
+	"ascii":        {"ascii", decodeSingle(asciiRune), encodeSingle(asciiByte)},
+	"iso-8859-1":   {"iso-8859-1", decodeSingle(latin1Rune), encodeSingle(latin1Byte)},
+	"windows-1252": {"windows-1252", decodeSingle(cp1252Rune), encodeSingle(cp1252Byte)},
+	"shift_jis":    {"shift_jis", decodeShiftJIS, encodeShiftJIS},
+}
+
+// aliases maps other common labels, with "-" and "_" removed, to the
+// canonical names.
+var aliases = map[string]string{
+	"utf8":        "utf-8",
+	"utf16":       "utf-16",
+	"utf16le":     "utf-16le",
+	"utf16be":     "utf-16be",
+	"ascii":       "ascii",
+	"usascii":     "ascii",
+	"latin1":      "iso-8859-1",
+	"iso88591":    "iso-8859-1",
+	"l1":          "iso-8859-1",
+	"windows1252": "windows-1252",
+	"cp1252":      "windows-1252",
+	"shiftjis":    "shift_jis",
+	"sjis":        "shift_jis",
+	"cp932":       "shift_jis",
+	"ms932":       "shift_jis",
+	"windows31j":  "shift_jis",
+}
+
+// lookup returns the codec for an encoding label.
+func lookup(label string) (codec, error) {
+	key := strings.NewReplacer("-", "", "_", "", " ", "").Replace(strings.ToLower(label))
+	if name, ok := aliases[key]; ok {
+		return codecs[name], nil
+	}
+	return codec{}, fmt.Errorf("unsupported encoding %q", label)
+}
+
+func decodeUTF8(data []byte, strict bool) ([]rune, int) {
+	data = trimPrefix(data, "\xef\xbb\xbf")
+	runes := make([]rune, 0, len(data))
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size == 1 && strict {
+			return nil, i
+		}
+		runes = append(runes, r)
+		i += size
+	}
+	return runes, -1
+}
+
+func encodeUTF8(dst []byte, r rune) ([]byte, bool) {
+	return utf8.AppendRune(dst, r), true
+}
+
+// decodeUTF16 decodes UTF-16 in the given byte order; with bom, a leading
+// byte order mark overrides it.
+func decodeUTF16(little, bom bool) func([]byte, bool) ([]rune, int) {
+	return func(data []byte, strict bool) ([]rune, int) {
+		// The codec is shared, so a byte order mark only applies to this call
+		le := little
+		switch {
+		case len(data) >= 2 && data[0] == 0xFF && data[1] == 0xFE && (bom || le):
+			le = true
+			data = data[2:]
+		case len(data) >= 2 && data[0] == 0xFE && data[1] == 0xFF && (bom || !le):
+			le = false
+			data = data[2:]
+		}
+		if len(data)%2 != 0 && strict {
+			return nil, len(data) - 1
+		}
+		units := make([]uint16, len(data)/2)
+		for i := range units {
+			if le {
+				units[i] = uint16(data[2*i]) | uint16(data[2*i+1])<<8
+			} else {
+				units[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+			}
+		}
+		if strict {
+			for i := 0; i < len(units); i++ {
+				switch u := units[i]; {
+				case u >= 0xD800 && u < 0xDC00 && i+1 < len(units) && units[i+1] >= 0xDC00 && units[i+1] < 0xE000:
+					i++
+				case u >= 0xD800 && u < 0xE000:
+					// Unpaired surrogate
+					return nil, 2 * i
+				}
+			}
+		}
+		runes := utf16.Decode(units)
+		if len(data)%2 != 0 {
+			runes = append(runes, utf8.RuneError)
+		}
+		return runes, -1
+	}
+}
+
+func encodeUTF16(little bool) func([]byte, rune) ([]byte, bool) {
+	return func(dst []byte, r rune) ([]byte, bool) {
+		for _, u := range utf16.Encode([]rune{r}) {
+			if little {
+				dst = append(dst, byte(u), byte(u>>8))
+			} else {
+				dst = append(dst, byte(u>>8), byte(u))
+			}
+		}
+		return dst, true
+	}
+}
+
+// decodeSingle decodes a single-byte encoding; toRune reports false for
+// unmapped bytes.
+func decodeSingle(toRune func(byte) (rune, bool)) func([]byte, bool) ([]rune, int) {
+	return func(data []byte, strict bool) ([]rune, int) {
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			r, ok := toRune(b)
+			if !ok {
+				if strict {
+					return nil, i
+				}
+				r = utf8.RuneError
+			}
+			runes[i] = r
+		}
+		return runes, -1
+	}
+}
+
+func encodeSingle(toByte func(rune) (byte, bool)) func([]byte, rune) ([]byte, bool) {
+	return func(dst []byte, r rune) ([]byte, bool) {
+		b, ok := toByte(r)
+		if !ok {
+			return dst, false
+		}
+		return append(dst, b), true
+	}
+}
+
+func asciiRune(b byte) (rune, bool) { return rune(b), b < 0x80 }
+
+func asciiByte(r rune) (byte, bool) { return byte(r), r >= 0 && r < 0x80 }
+
+func latin1Rune(b byte) (rune, bool) { return rune(b), true }
+
+func latin1Byte(r rune) (byte, bool) { return byte(r), r >= 0 && r <= 0xFF }
+
+// cp1252High maps bytes 0x80-0x9F of Windows-1252. The five unassigned
+// bytes map to the C1 controls of the same value, as browsers do.
+var cp1252High = [32]rune{
+	0x20AC, 0x0081, 0x201A, 0x0192, 0x201E, 0x2026, 0x2020, 0x2021,
+	0x02C6, 0x2030, 0x0160, 0x2039, 0x0152, 0x008D, 0x017D, 0x008F,
+	0x0090, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+	0x02DC, 0x2122, 0x0161, 0x203A, 0x0153, 0x009D, 0x017E, 0x0178,
+}
+
+func cp1252Rune(b byte) (rune, bool) {
+	if b >= 0x80 && b <= 0x9F {
+		return cp1252High[b-0x80], true
+	}
+	return rune(b), true
+}
+
+func cp1252Byte(r rune) (byte, bool) {
+	if r >= 0x80 && r <= 0x9F || r > 0xFF {
+		for i, h := range cp1252High {
+			if h == r {
+				return byte(0x80 + i), true
+			}
+		}
+		return 0, false
+	}
+	return byte(r), r >= 0
+}
+
+// isSJISLead reports whether b starts a double-byte Shift-JIS character.
+func isSJISLead(b byte) bool {
+	return b >= 0x81 && b <= 0x9F || b >= 0xE0 && b <= 0xFC
+}
+
+// sjisPair returns the character for a lead and trail byte, if any.
+func sjisPair(lead, trail byte) (rune, bool) {
+	if trail < 0x40 || trail > 0xFC {
+		return 0, false
+	}
+	row := int(lead) - 0x81
+	if lead >= 0xE0 {
+		row = int(lead) - 0xE0 + 0x1F
+	}
+	// Rows are mostly multi-byte UTF-8, so walk them by rune
+	i := int(trail - 0x40)
+	for _, r := range sjisRows[row] {
+		if i == 0 {
+			return r, r != utf8.RuneError
+		}
+		i--
+	}
+	return 0, false
+}
+
+func decodeShiftJIS(data []byte, strict bool) ([]rune, int) {
+	runes := make([]rune, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		switch {
+		case b <= 0x80:
+			runes = append(runes, rune(b))
+			continue
+		case b >= 0xA1 && b <= 0xDF:
+			// Half-width katakana
+			runes = append(runes, 0xFF61+rune(b-0xA1))
+			continue
+		case isSJISLead(b) && i+1 < len(data):
+			if r, ok := sjisPair(b, data[i+1]); ok {
+				runes = append(runes, r)
+				i++
+				continue
+			}
+		}
+		if strict {
+			return nil, i
+		}
+		runes = append(runes, utf8.RuneError)
+	}
+	return runes, -1
+}
+
+var (
+	sjisOnce    sync.Once
+	sjisEncoder map[rune][2]byte
+)
+
+func encodeShiftJIS(dst []byte, r rune) ([]byte, bool) {
+	switch {
+	case r <= 0x80:
+		return append(dst, byte(r)), true
+	case r >= 0xFF61 && r <= 0xFF9F:
+		return append(dst, byte(r-0xFF61+0xA1)), true
+	}
+	sjisOnce.Do(func() {
+		sjisEncoder = make(map[rune][2]byte)
+		for row, s := range sjisRows {
+			lead := byte(0x81 + row)
+			if row >= 0x1F {
+				lead = byte(0xE0 + row - 0x1F)
+			}
+			trail := byte(0x40)
+			for _, c := range s {
+				if _, seen := sjisEncoder[c]; !seen && c != utf8.RuneError {
+					sjisEncoder[c] = [2]byte{lead, trail}
+				}
+				trail++
+			}
+		}
+	})
+	pair, ok := sjisEncoder[r]
+	if !ok {
+		return dst, false
+	}
+	return append(dst, pair[0], pair[1]), true
+}
+
+func trimPrefix(data []byte, prefix string) []byte {
+	if len(data) >= len(prefix) && string(data[:len(prefix)]) == prefix {
+		return data[len(prefix):]
+	}
+	return data
+}
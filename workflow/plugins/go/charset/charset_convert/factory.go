@@ -0,0 +1,7 @@
+// Package charset_convert provides factory for CharsetConvert plugin.
+package charset_convert
+
+// Create returns a new CharsetConvert instance.
+func Create() *CharsetConvert {
+	return NewCharsetConvert()
+}
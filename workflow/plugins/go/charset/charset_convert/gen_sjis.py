@@ -0,0 +1,41 @@
+#!/usr/bin/env python3
+"""Generate sjis_table.go for charset.convert from Python's cp932 codec.
+
+Code page 932 (Windows-31J) is the Shift-JIS that Windows and browsers
+use: JIS X 0208 plus NEC and IBM extensions such as circled numbers.
+
+Run with `go generate ./charset/charset_convert`.
+"""
+import subprocess
+
+LEADS = list(range(0x81, 0xA0)) + list(range(0xE0, 0xFD))
+TRAILS = range(0x40, 0xFD)
+
+
+def decode(lead, trail):
+    try:
+        return bytes([lead, trail]).decode("cp932")
+    except UnicodeDecodeError:
+        return "�"
+
+
+def go_string(s):
+    return '"' + "".join("\\u%04X" % ord(c) if ord(c) < 0x20 or ord(c) == 0xFFFD or 0x7F <= ord(c) <= 0x9F or c in '"\\' else c
+                         for c in s) + '"'
+
+
+out = []
+w = out.append
+w("// Code generated by gen_sjis.py; DO NOT EDIT.\n\n")
+w("package charset_convert\n\n")
+w("// sjisRows holds the double-byte Shift-JIS (code page 932) characters,\n")
+w("// one string per lead byte (0x81-0x9F, then 0xE0-0xFC) with a rune for\n")
+w("// each trail byte from 0x40 to 0xFC. Unmapped pairs are U+FFFD.\n")
+w("var sjisRows = [...]string{\n")
+for lead in LEADS:
+    w("\t%s, // 0x%02X\n" % (go_string("".join(decode(lead, t) for t in TRAILS)), lead))
+w("}\n")
+
+with open("sjis_table.go", "w") as f:
+    f.write("".join(out))
+subprocess.run(["gofmt", "-w", "sjis_table.go"], check=True)
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/metabuilder/workflow-plugins-go/registry"
+	"github.com/metabuilder/workflow-plugins-go/server"
+)
+
+// catalogCategory groups the nodes of one category for the docs output.
+type catalogCategory struct {
+	Name  string           `json:"name"`
+	Nodes []*registry.Node `json:"nodes"`
+}
+
+// docsCmd implements "metabuilder docs".
+func docsCmd(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("docs", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "markdown", "output format: markdown, json, yaml or openapi")
+	out := fs.String("out", "", "write to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	reg := loadRegistry(stderr)
+	categories := groupByCategory(reg)
+
+	w := stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+
+	var err error
+	switch *format {
+	case "markdown", "md":
+		err = writeMarkdownCatalog(w, categories)
+	case formatJSON, formatYAML:
+		err = writeValue(w, *format, map[string]interface{}{"categories": categories})
+	case "openapi":
+		err = writeValue(w, formatJSON, server.New(server.WithRegistry(reg)).OpenAPI())
+	default:
+		fmt.Fprintf(stderr, "metabuilder docs: unknown format %q\n", *format)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// groupByCategory returns the registry contents grouped by category.
+func groupByCategory(r *registry.Registry) []catalogCategory {
+	var categories []catalogCategory
+	for _, node := range r.Nodes() {
+		// Nodes are ordered by category, so a new category starts a new group
+		if n := len(categories); n == 0 || categories[n-1].Name != node.Category {
+			categories = append(categories, catalogCategory{Name: node.Category})
+		}
+		last := &categories[len(categories)-1]
+		last.Nodes = append(last.Nodes, node)
+	}
+	return categories
+}
+
+// writeMarkdownCatalog renders the catalog as Markdown.
+func writeMarkdownCatalog(w io.Writer, categories []catalogCategory) error {
+	var b strings.Builder
+
+	b.WriteString("# Go Node Catalog\n\n")
+	b.WriteString("Generated by `metabuilder docs`. Do not edit by hand.\n\n")
+	for _, c := range categories {
+		fmt.Fprintf(&b, "- [%s](#%s) (%d)\n", c.Name, c.Name, len(c.Nodes))
+	}
+
+	for _, c := range categories {
+		fmt.Fprintf(&b, "\n## %s\n", c.Name)
+		for _, node := range c.Nodes {
+			fmt.Fprintf(&b, "\n### `%s`\n\n%s\n", node.NodeType, node.Description)
+			writeMarkdownPorts(&b, "Inputs", node.Inputs, true)
+			writeMarkdownPorts(&b, "Outputs", node.Outputs, false)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMarkdownPorts renders a table of ports.
+func writeMarkdownPorts(b *strings.Builder, title string, ports []registry.Port, withOptional bool) {
+	if len(ports) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n**%s**\n\n", title)
+	if withOptional {
+		b.WriteString("| Name | Optional | Description |\n|------|----------|-------------|\n")
+	} else {
+		b.WriteString("| Name | Description |\n|------|-------------|\n")
+	}
+	for _, p := range ports {
+		description := strings.ReplaceAll(p.Description, "|", `\|`)
+		if !withOptional {
+			fmt.Fprintf(b, "| `%s` | %s |\n", p.Name, description)
+			continue
+		}
+		optional := ""
+		if p.Optional {
+			optional = "yes"
+		}
+		fmt.Fprintf(b, "| `%s` | %s | %s |\n", p.Name, optional, description)
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"flag"
+)
+
+// parseInterspersed parses flags that may appear before or after positional
+// arguments, e.g. "run-node list.sort --inputs {}". It returns the positionals.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
@@ -0,0 +1,69 @@
+// Command metabuilder is a command-line front end for the Go workflow plugins.
+//
+// Usage:
+//
+//	metabuilder plugins list [--category name] [--format table|json|yaml]
+//	metabuilder plugins describe <node-type> [--format table|json|yaml]
+//	metabuilder run-node <node-type> [--inputs json] [--format json|yaml|table]
+//	metabuilder docs [--format markdown|json|yaml|openapi] [--out file]
+//	metabuilder repl
+//	metabuilder serve [--addr :8080] [--token secret]
+//	metabuilder stdio
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run dispatches to a subcommand and returns the process exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	switch args[0] {
+	case "plugins":
+		return pluginsCmd(args[1:], stdout, stderr)
+	case "run-node":
+		return runNodeCmd(args[1:], stdin, stdout, stderr)
+	case "docs":
+		return docsCmd(args[1:], stdout, stderr)
+	case "repl":
+		return replCmd(args[1:], stdin, stdout, stderr)
+	case "serve":
+		return serveCmd(args[1:], stdout, stderr)
+	case "stdio":
+		return stdioCmd(args[1:], stdin, stdout, stderr)
+	case "help", "-h", "--help":
+		usage(stdout)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "metabuilder: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+}
+
+// usage prints the top-level help text.
+func usage(w io.Writer) {
+	fmt.Fprintln(w, `Usage: metabuilder <command> [arguments]
+
+Commands:
+  plugins list [--category name]   list available node plugins
+  plugins describe <node-type>     show a plugin's inputs and outputs
+  run-node <node-type> [--inputs]  execute a single plugin and print its output
+  docs [--format] [--out file]     generate the node catalog as Markdown or JSON
+  repl                             evaluate plugin calls interactively
+  serve [--addr] [--token]         serve the plugins over HTTP
+  stdio                            serve JSON-RPC requests on stdin/stdout
+
+Most commands accept --format json, yaml or table. Go plugins built with
+-buildmode=plugin are loaded from $METABUILDER_PLUGIN_DIR.`)
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats accepted by --format.
+const (
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+	formatTable = "table"
+)
+
+// checkFormat reports an error if format is not one of allowed.
+func checkFormat(format string, allowed ...string) error {
+	for _, a := range allowed {
+		if format == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown format %q (want %s)", format, strings.Join(allowed, ", "))
+}
+
+// writeValue writes v as JSON or YAML. Table output is command specific.
+func writeValue(w io.Writer, format string, v interface{}) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatYAML:
+		return writeYAML(w, v)
+	default:
+		return fmt.Errorf("format %q is not supported here", format)
+	}
+}
+
+// writeKeyValueTable writes the top-level entries of a map as KEY/VALUE rows,
+// encoding non-scalar values as compact JSON.
+func writeKeyValueTable(w io.Writer, m map[string]interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tVALUE")
+	for _, key := range sortedKeys(m) {
+		fmt.Fprintf(tw, "%s\t%s\n", key, compactValue(m[key]))
+	}
+	return tw.Flush()
+}
+
+// compactValue renders a value for a single table cell.
+func compactValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// writeYAML writes v as a YAML document. The value is first encoded as JSON,
+// so struct field order and json tags are preserved.
+func writeYAML(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	node, err := readOrdered(dec)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	emitYAML(&b, node, 0, false)
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// orderedField is a key/value pair of a JSON object in document order.
+type orderedField struct {
+	key   string
+	value interface{}
+}
+
+// orderedObject is a JSON object that remembers its key order.
+type orderedObject []orderedField
+
+// readOrdered decodes the next JSON value, keeping object keys in order.
+func readOrdered(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			var obj orderedObject
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := readOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+				obj = append(obj, orderedField{key: keyTok.(string), value: value})
+			}
+			_, err := dec.Token()
+			return obj, err
+		case '[':
+			list := []interface{}{}
+			for dec.More() {
+				value, err := readOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, value)
+			}
+			_, err := dec.Token()
+			return list, err
+		}
+		return nil, fmt.Errorf("unexpected delimiter %v", t)
+	default:
+		return t, nil
+	}
+}
+
+// emitYAML writes node at the given indentation. inline is true when the
+// node follows a "- " list marker on the same line.
+func emitYAML(b *strings.Builder, node interface{}, indent int, inline bool) {
+	pad := strings.Repeat("  ", indent)
+
+	switch n := node.(type) {
+	case orderedObject:
+		if len(n) == 0 {
+			b.WriteString("{}\n")
+			return
+		}
+		for i, f := range n {
+			if i > 0 || !inline {
+				b.WriteString(pad)
+			}
+			b.WriteString(yamlString(f.key))
+			b.WriteString(":")
+			emitChild(b, f.value, indent)
+		}
+	case []interface{}:
+		if len(n) == 0 {
+			b.WriteString("[]\n")
+			return
+		}
+		for i, item := range n {
+			if i > 0 || !inline {
+				b.WriteString(pad)
+			}
+			b.WriteString("- ")
+			if isCollection(item) && !isEmptyCollection(item) {
+				emitYAML(b, item, indent+1, true)
+			} else {
+				b.WriteString(yamlScalar(item))
+				b.WriteString("\n")
+			}
+		}
+	default:
+		b.WriteString(yamlScalar(n))
+		b.WriteString("\n")
+	}
+}
+
+// emitChild writes the value of a mapping entry after its "key:".
+func emitChild(b *strings.Builder, value interface{}, indent int) {
+	if isCollection(value) && !isEmptyCollection(value) {
+		b.WriteString("\n")
+		emitYAML(b, value, indent+1, false)
+		return
+	}
+	b.WriteString(" ")
+	if isCollection(value) {
+		emitYAML(b, value, indent+1, true)
+		return
+	}
+	b.WriteString(yamlScalar(value))
+	b.WriteString("\n")
+}
+
+func isCollection(v interface{}) bool {
+	switch v.(type) {
+	case orderedObject, []interface{}:
+		return true
+	}
+	return false
+}
+
+func isEmptyCollection(v interface{}) bool {
+	switch c := v.(type) {
+	case orderedObject:
+		return len(c) == 0
+	case []interface{}:
+		return len(c) == 0
+	}
+	return false
+}
+
+// yamlScalar renders a scalar JSON token as YAML.
+func yamlScalar(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		if s {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return s.String()
+	case string:
+		return yamlString(s)
+	default:
+		return fmt.Sprintf("%v", s)
+	}
+}
+
+// plainYAML matches strings that can be written without quotes.
+var plainYAML = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_ ./()-]*$`)
+
+// yamlReserved lists plain scalars YAML would not read back as strings.
+var yamlReserved = map[string]bool{
+	"true": true, "false": true, "yes": true, "no": true, "on": true, "off": true,
+	"null": true, "y": true, "n": true, "~": true,
+}
+
+// yamlString quotes s when it would otherwise be misread.
+func yamlString(s string) string {
+	if plainYAML.MatchString(s) && !strings.HasSuffix(s, " ") && !yamlReserved[strings.ToLower(s)] {
+		return s
+	}
+	// JSON string syntax is valid YAML double-quoted syntax
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
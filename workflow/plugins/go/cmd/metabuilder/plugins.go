@@ -0,0 +1,125 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// pluginsCmd implements "metabuilder plugins".
+func pluginsCmd(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "usage: metabuilder plugins <list|describe> [arguments]")
+		return 2
+	}
+
+	switch args[0] {
+	case "list":
+		return pluginsList(args[1:], stdout, stderr)
+	case "describe":
+		return pluginsDescribe(args[1:], stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "metabuilder plugins: unknown subcommand %q\n", args[0])
+		return 2
+	}
+}
+
+// pluginsList prints every registered plugin, optionally filtered by category.
+func pluginsList(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("plugins list", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	category := fs.String("category", "", "only list plugins in this category")
+	format := fs.String("format", formatTable, "output format: table, json or yaml")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if err := checkFormat(*format, formatTable, formatJSON, formatYAML); err != nil {
+		fmt.Fprintf(stderr, "metabuilder plugins list: %v\n", err)
+		return 2
+	}
+
+	nodes := []*registry.Node{}
+	for _, node := range loadRegistry(stderr).Nodes() {
+		if *category == "" || node.Category == *category {
+			nodes = append(nodes, node)
+		}
+	}
+
+	if *format != formatTable {
+		if err := writeValue(stdout, *format, nodes); err != nil {
+			fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NODE TYPE\tCATEGORY\tDESCRIPTION")
+	for _, node := range nodes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", node.NodeType, node.Category, node.Description)
+	}
+	tw.Flush()
+	return 0
+}
+
+// pluginsDescribe prints the metadata and ports of a single plugin.
+func pluginsDescribe(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("plugins describe", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", formatTable, "output format: table, json or yaml")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fmt.Fprintln(stderr, "usage: metabuilder plugins describe <node-type> [--format table|json|yaml]")
+		return 2
+	}
+	if err := checkFormat(*format, formatTable, formatJSON, formatYAML); err != nil {
+		fmt.Fprintf(stderr, "metabuilder plugins describe: %v\n", err)
+		return 2
+	}
+
+	node, ok := loadRegistry(stderr).Get(positional[0])
+	if !ok {
+		fmt.Fprintf(stderr, "metabuilder: unknown node type %q\n", positional[0])
+		return 1
+	}
+
+	if *format != formatTable {
+		if err := writeValue(stdout, *format, node); err != nil {
+			fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Node type:\t%s\n", node.NodeType)
+	fmt.Fprintf(tw, "Category:\t%s\n", node.Category)
+	fmt.Fprintf(tw, "Description:\t%s\n", node.Description)
+	fmt.Fprintf(tw, "Package:\t%s\n", node.Package)
+	writePorts(tw, "Inputs", node.Inputs)
+	writePorts(tw, "Outputs", node.Outputs)
+	tw.Flush()
+	return 0
+}
+
+// writePorts prints a section of documented ports.
+func writePorts(w io.Writer, title string, ports []registry.Port) {
+	fmt.Fprintf(w, "\n%s:\n", title)
+	if len(ports) == 0 {
+		fmt.Fprintln(w, "  (none documented)")
+		return
+	}
+	for _, p := range ports {
+		optional := ""
+		if p.Optional {
+			optional = "optional"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", p.Name, optional, p.Description)
+	}
+}
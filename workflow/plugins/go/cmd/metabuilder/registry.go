@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// pluginDirEnv names the directory of shared-object plugins loaded at startup.
+const pluginDirEnv = "METABUILDER_PLUGIN_DIR"
+
+// loadRegistry returns the builtin registry plus the plugins in
+// $METABUILDER_PLUGIN_DIR. Plugins that fail to load are reported on stderr
+// and skipped.
+func loadRegistry(stderr io.Writer) *registry.Registry {
+	r := registry.Builtin()
+	if dir := os.Getenv(pluginDirEnv); dir != "" {
+		if err := r.LoadPlugins(dir); err != nil {
+			fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+		}
+	}
+	return r
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+const replHelp = `Enter a node invocation, optionally piped into further invocations:
+
+  list.sort {"list": [3, 1, 2]}
+  list.sort list=[3,1,2] | list.reverse list=$_.result
+
+Inputs are a JSON object or key=value pairs (values are parsed as JSON when
+possible). "$_" refers to the previous output and "$_.a.b" to a field of it.
+The store is kept for the whole session, so var.set / var.get work across
+lines.
+
+Commands:
+  :list [category]      list node types
+  :describe <type>      show a node's inputs and outputs
+  :store                print the session store
+  :set <key> <json>     set a store variable
+  :clear                empty the store and forget the previous output
+  :help                 show this help
+  :quit                 leave the REPL`
+
+// replCmd implements "metabuilder repl".
+func replCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("repl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	debug := fs.Bool("debug", false, "print debug log messages")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	s := &replSession{
+		registry: loadRegistry(stderr),
+		runtime:  newRuntime(stderr, *debug),
+		stdout:   stdout,
+	}
+
+	fmt.Fprintln(stdout, `metabuilder repl - type :help for help, :quit to exit`)
+	scanner := bufio.NewScanner(stdin)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for {
+		fmt.Fprint(stdout, "mb> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(stdout)
+			break
+		}
+		err := s.eval(scanner.Text())
+		if errors.Is(err, errQuit) {
+			break
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %v\n", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// errQuit is returned by eval when the user asks to leave.
+var errQuit = errors.New("quit")
+
+// replSession holds the state shared by all lines of a REPL session.
+type replSession struct {
+	registry *registry.Registry
+	runtime  *plugin.Runtime
+	stdout   io.Writer
+	last     map[string]interface{}
+}
+
+// eval executes a single input line.
+func (s *replSession) eval(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+	if strings.HasPrefix(line, ":") {
+		return s.command(line[1:])
+	}
+
+	for _, stage := range splitPipeline(line) {
+		output, err := s.invoke(stage)
+		if err != nil {
+			return err
+		}
+		s.last = output
+	}
+	return s.print(s.last)
+}
+
+// command executes a ":" meta command.
+func (s *replSession) command(line string) error {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return fmt.Errorf("empty command, try :help")
+	}
+
+	switch fields[0] {
+	case "quit", "q", "exit":
+		return errQuit
+	case "help", "h":
+		fmt.Fprintln(s.stdout, replHelp)
+	case "list", "ls":
+		for _, node := range s.registry.Nodes() {
+			if len(fields) > 1 && node.Category != fields[1] {
+				continue
+			}
+			fmt.Fprintf(s.stdout, "%-24s %s\n", node.NodeType, node.Description)
+		}
+	case "describe":
+		if len(fields) != 2 {
+			return fmt.Errorf("usage: :describe <node-type>")
+		}
+		node, ok := s.registry.Get(fields[1])
+		if !ok {
+			return fmt.Errorf("unknown node type %q", fields[1])
+		}
+		tw := tabwriter.NewWriter(s.stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(tw, "%s - %s\n", node.NodeType, node.Description)
+		writePorts(tw, "Inputs", node.Inputs)
+		writePorts(tw, "Outputs", node.Outputs)
+		tw.Flush()
+	case "store":
+		return s.print(s.runtime.Store)
+	case "set":
+		if len(fields) < 3 {
+			return fmt.Errorf("usage: :set <key> <json>")
+		}
+		_, raw, _ := strings.Cut(strings.TrimSpace(line)[len("set"):], fields[1])
+		value, err := s.resolve(parseValue(strings.TrimSpace(raw)))
+		if err != nil {
+			return err
+		}
+		s.runtime.Store[fields[1]] = value
+	case "clear":
+		s.runtime.Store = make(map[string]interface{})
+		s.last = nil
+	default:
+		return fmt.Errorf("unknown command :%s, try :help", fields[0])
+	}
+	return nil
+}
+
+// invoke runs one pipeline stage such as `dict.get key=name dict=$_.result`.
+func (s *replSession) invoke(stage string) (map[string]interface{}, error) {
+	stage = strings.TrimSpace(stage)
+	nodeType, rest := stage, ""
+	if i := strings.IndexAny(stage, " \t"); i >= 0 {
+		nodeType, rest = stage[:i], strings.TrimSpace(stage[i+1:])
+	}
+
+	node, ok := s.registry.Get(nodeType)
+	if !ok {
+		return nil, fmt.Errorf("unknown node type %q", nodeType)
+	}
+
+	inputs, err := s.parseInputs(rest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", nodeType, err)
+	}
+
+	return node.Executor.Execute(inputs, s.runtime), nil
+}
+
+// parseInputs parses a JSON object or key=value pairs and resolves $_ references.
+func (s *replSession) parseInputs(text string) (map[string]interface{}, error) {
+	inputs := make(map[string]interface{})
+	if text == "" {
+		return inputs, nil
+	}
+
+	if strings.HasPrefix(text, "{") {
+		if err := json.Unmarshal([]byte(text), &inputs); err != nil {
+			return nil, fmt.Errorf("invalid JSON inputs: %w", err)
+		}
+	} else {
+		for _, pair := range splitFields(text) {
+			key, raw, ok := strings.Cut(pair, "=")
+			if !ok || key == "" {
+				return nil, fmt.Errorf("expected key=value, got %q", pair)
+			}
+			inputs[key] = parseValue(raw)
+		}
+	}
+
+	for key, value := range inputs {
+		resolved, err := s.resolve(value)
+		if err != nil {
+			return nil, err
+		}
+		inputs[key] = resolved
+	}
+	return inputs, nil
+}
+
+// resolve replaces "$_" references with values from the previous output.
+func (s *replSession) resolve(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if v != "$_" && !strings.HasPrefix(v, "$_.") {
+			return v, nil
+		}
+		if s.last == nil {
+			return nil, fmt.Errorf("%s: no previous output", v)
+		}
+		var current interface{} = s.last
+		if v == "$_" {
+			return current, nil
+		}
+		for _, part := range strings.Split(v[len("$_."):], ".") {
+			obj, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: cannot descend into %q", v, part)
+			}
+			current = obj[part]
+		}
+		return current, nil
+	case map[string]interface{}:
+		for k, item := range v {
+			resolved, err := s.resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = resolved
+		}
+		return v, nil
+	case []interface{}:
+		for i, item := range v {
+			resolved, err := s.resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// print writes a value as indented JSON.
+func (s *replSession) print(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(s.stdout, string(data))
+	return nil
+}
+
+// parseValue decodes raw as JSON, falling back to the raw string.
+func parseValue(raw string) interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return raw
+	}
+	return value
+}
+
+// splitPipeline splits a line on "|" outside of JSON strings and brackets.
+func splitPipeline(line string) []string {
+	return splitOutside(line, func(r rune) bool { return r == '|' })
+}
+
+// splitFields splits key=value pairs on whitespace outside of JSON strings and brackets.
+func splitFields(text string) []string {
+	return splitOutside(text, func(r rune) bool { return r == ' ' || r == '\t' })
+}
+
+// splitOutside splits text on separator runes that are not nested inside
+// quotes, braces or brackets. Empty parts are dropped.
+func splitOutside(text string, isSep func(rune) bool) []string {
+	var parts []string
+	var current strings.Builder
+	depth := 0
+	inString := false
+	escaped := false
+
+	flush := func() {
+		if part := strings.TrimSpace(current.String()); part != "" {
+			parts = append(parts, part)
+		}
+		current.Reset()
+	}
+
+	for _, r := range text {
+		switch {
+		case escaped:
+			escaped = false
+		case inString && r == '\\':
+			escaped = true
+		case r == '"':
+			inString = !inString
+		case inString:
+		case r == '{' || r == '[':
+			depth++
+		case (r == '}' || r == ']') && depth > 0:
+			depth--
+		case depth == 0 && isSep(r):
+			flush()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	flush()
+	return parts
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// runNodeCmd implements "metabuilder run-node <node-type> --inputs <json>".
+func runNodeCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("run-node", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	inputsArg := fs.String("inputs", "{}", "node inputs as a JSON object, @file to read a file, or - for stdin")
+	debug := fs.Bool("debug", false, "print debug log messages")
+	format := fs.String("format", formatJSON, "output format: json, yaml or table")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return 2
+	}
+	if len(positional) != 1 {
+		fmt.Fprintln(stderr, "usage: metabuilder run-node <node-type> [--inputs json] [--format json|yaml|table]")
+		return 2
+	}
+	if err := checkFormat(*format, formatJSON, formatYAML, formatTable); err != nil {
+		fmt.Fprintf(stderr, "metabuilder run-node: %v\n", err)
+		return 2
+	}
+
+	node, ok := loadRegistry(stderr).Get(positional[0])
+	if !ok {
+		fmt.Fprintf(stderr, "metabuilder: unknown node type %q\n", positional[0])
+		return 1
+	}
+
+	inputs, err := readInputs(*inputsArg, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+		return 1
+	}
+
+	output := node.Executor.Execute(inputs, newRuntime(stderr, *debug))
+
+	if *format == formatTable {
+		err = writeKeyValueTable(stdout, output)
+	} else {
+		err = writeValue(stdout, *format, output)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "metabuilder: encoding output: %v\n", err)
+		return 1
+	}
+
+	if output["error"] != nil {
+		return 1
+	}
+	return 0
+}
+
+// readInputs decodes node inputs from a literal, @file or - (stdin).
+func readInputs(arg string, stdin io.Reader) (map[string]interface{}, error) {
+	var data []byte
+	var err error
+
+	switch {
+	case arg == "-":
+		data, err = io.ReadAll(stdin)
+	case strings.HasPrefix(arg, "@"):
+		data, err = os.ReadFile(arg[1:])
+	default:
+		data = []byte(arg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading inputs: %w", err)
+	}
+
+	var inputs map[string]interface{}
+	if err := json.Unmarshal(data, &inputs); err != nil {
+		return nil, fmt.Errorf("inputs must be a JSON object: %w", err)
+	}
+	if inputs == nil {
+		inputs = make(map[string]interface{})
+	}
+	return inputs, nil
+}
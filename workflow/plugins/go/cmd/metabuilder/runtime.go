@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+)
+
+// writerLogger implements plugin.Logger by writing prefixed lines to w.
+type writerLogger struct {
+	w     io.Writer
+	debug bool
+}
+
+func (l *writerLogger) Info(msg string)  { fmt.Fprintf(l.w, "INFO  %s\n", msg) }
+func (l *writerLogger) Error(msg string) { fmt.Fprintf(l.w, "ERROR %s\n", msg) }
+
+func (l *writerLogger) Debug(msg string) {
+	if l.debug {
+		fmt.Fprintf(l.w, "DEBUG %s\n", msg)
+	}
+}
+
+// newRuntime creates a runtime with an empty store that logs to stderr.
+func newRuntime(stderr io.Writer, debug bool) *plugin.Runtime {
+	return &plugin.Runtime{
+		Store:   make(map[string]interface{}),
+		Context: make(map[string]interface{}),
+		Logger:  &writerLogger{w: stderr, debug: debug},
+		Events:  plugin.NewEventBus(),
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/metabuilder/workflow-plugins-go/server"
+)
+
+// serveCmd implements "metabuilder serve".
+func serveCmd(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	token := fs.String("token", os.Getenv("METABUILDER_TOKEN"), "require this bearer token (default $METABUILDER_TOKEN)")
+	shutdown := fs.Duration("shutdown-timeout", 10*time.Second, "time allowed for in-flight requests on shutdown")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	opts := []server.Option{server.WithRegistry(loadRegistry(stderr))}
+	if *token != "" {
+		opts = append(opts, server.WithAuth(server.BearerToken(*token)))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(stdout, "listening on %s\n", *addr)
+	if err := server.New(opts...).ListenAndServe(ctx, *addr, *shutdown); err != nil {
+		fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+		return 1
+	}
+	return 0
+}
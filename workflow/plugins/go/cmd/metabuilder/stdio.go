@@ -0,0 +1,30 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+	"github.com/metabuilder/workflow-plugins-go/jsonrpc"
+)
+
+// stdioCmd implements "metabuilder stdio". Responses go to stdout and log
+// messages to stderr, so the protocol stream stays clean.
+func stdioCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("stdio", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	debug := fs.Bool("debug", false, "print debug log messages to stderr")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	h := &jsonrpc.Handler{Registry: loadRegistry(stderr)}
+	rt := newRuntime(stderr, *debug)
+	h.NewRuntime = func() *plugin.Runtime { return rt }
+	if err := h.Serve(stdin, stdout); err != nil {
+		fmt.Fprintf(stderr, "metabuilder: %v\n", err)
+		return 1
+	}
+	return 0
+}
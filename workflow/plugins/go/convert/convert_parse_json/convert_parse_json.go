@@ -22,6 +22,12 @@ func NewConvertParseJson() *ConvertParseJson {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - string: the JSON string to parse
+//
+// Returns:
+//   - result: the parsed value
+//   - error: set if the string is not valid JSON
 func (p *ConvertParseJson) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	str, ok := inputs["string"].(string)
 	if !ok {
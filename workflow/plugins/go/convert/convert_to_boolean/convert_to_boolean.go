@@ -22,6 +22,11 @@ func NewConvertToBoolean() *ConvertToBoolean {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - value: the value to convert ("true", "1" and "yes" are truthy strings)
+//
+// Returns:
+//   - result: the boolean value
 func (p *ConvertToBoolean) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	value := inputs["value"]
 
@@ -22,6 +22,13 @@ func NewConvertToJson() *ConvertToJson {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - value: the value to serialize
+//   - pretty: (optional) indent the output (default: false)
+//
+// Returns:
+//   - result: the JSON string
+//   - error: set if the value cannot be serialized
 func (p *ConvertToJson) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	value := inputs["value"]
 	pretty := false
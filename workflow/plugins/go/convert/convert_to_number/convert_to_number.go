@@ -22,6 +22,12 @@ func NewConvertToNumber() *ConvertToNumber {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - value: the value to convert (number, numeric string or boolean)
+//
+// Returns:
+//   - result: the numeric value
+//   - error: set if the value cannot be converted
 func (p *ConvertToNumber) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	value := inputs["value"]
 
@@ -23,6 +23,11 @@ func NewConvertToString() *ConvertToString {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - value: the value to convert (complex types are JSON encoded)
+//
+// Returns:
+//   - result: the string value
 func (p *ConvertToString) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	value := inputs["value"]
 
@@ -0,0 +1,145 @@
+// Package decimal does exact decimal arithmetic for the math plugins'
+// decimal precision mode, so that 0.1 + 0.2 is 0.3 rather than
+// 0.30000000000000004.
+//
+// Values are math/big rationals. Numbers come in as decimal strings (to
+// stay exact through JSON) or as Go numbers, which are taken at their
+// shortest decimal representation, and go out as decimal strings.
+package decimal
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultScale is the number of decimal places kept when a result has no
+// exact decimal representation, such as 1 / 3.
+const DefaultScale = 20
+
+// maxExponent bounds the exponent of numbers written like 1e10.
+const maxExponent = 1000
+
+// maxDigits bounds the numerator and denominator of every number, parsed or
+// computed, so that a long chain of operations cannot build values that
+// take minutes to multiply or megabytes to print. It leaves room for the
+// product or quotient of two numbers at the exponent limits.
+const maxDigits = 4 * maxExponent
+
+// pattern matches a decimal number, capturing its exponent.
+var pattern = regexp.MustCompile(`^[+-]?(?:\d+\.?\d*|\.\d+)(?:[eE]([+-]?\d+))?$`)
+
+// Parse converts a decimal string or a number to an exact rational.
+func Parse(v interface{}) (*big.Rat, error) {
+	var s string
+	switch n := v.(type) {
+	case string:
+		s = strings.TrimSpace(n)
+	case json.Number:
+		s = n.String()
+	case float64:
+		if math.IsInf(n, 0) || math.IsNaN(n) {
+			return nil, fmt.Errorf("%v is not a finite number", n)
+		}
+		s = strconv.FormatFloat(n, 'g', -1, 64)
+	case float32:
+		s = strconv.FormatFloat(float64(n), 'g', -1, 32)
+	case int:
+		return new(big.Rat).SetInt64(int64(n)), nil
+	case int64:
+		return new(big.Rat).SetInt64(n), nil
+	default:
+		return nil, fmt.Errorf("%v is not a number", v)
+	}
+
+	// big.Rat also reads fractions and hexadecimal; only accept decimals,
+	// and bound the exponent so that "1e999999999" cannot exhaust memory
+	m := pattern.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("%q is not a decimal number", s)
+	}
+	if m[1] != "" {
+		if exp, err := strconv.Atoi(m[1]); err != nil || exp < -maxExponent || exp > maxExponent {
+			return nil, fmt.Errorf("exponent of %q must be between %d and %d", s, -maxExponent, maxExponent)
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a decimal number", s)
+	}
+	if err := Check(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Check returns an error if r is too large or too precise to compute with,
+// that is if its numerator or denominator has more than maxDigits digits.
+func Check(r *big.Rat) error {
+	if digits(r.Num()) > maxDigits || digits(r.Denom()) > maxDigits {
+		return fmt.Errorf("number needs more than %d digits", maxDigits)
+	}
+	return nil
+}
+
+// digits returns about the number of decimal digits of n, from its bit
+// length.
+func digits(n *big.Int) int {
+	return int(math.Ceil(float64(n.BitLen()) * math.Log10(2)))
+}
+
+// String formats r as a decimal without trailing zeros. Values without an
+// exact decimal form are rounded half to even to scale places.
+func String(r *big.Rat, scale int) string {
+	places, exact := decimalPlaces(r.Denom())
+	if !exact {
+		r = round(r, scale)
+		places = scale
+	}
+	s := r.FloatString(places)
+	if strings.ContainsRune(s, '.') {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// decimalPlaces returns the number of decimal places needed to write a
+// fraction with denominator d exactly, and false if it never terminates.
+func decimalPlaces(d *big.Int) (int, bool) {
+	d = new(big.Int).Set(d)
+	two, five := big.NewInt(2), big.NewInt(5)
+	var twos, fives int
+	m := new(big.Int)
+	for d.Cmp(big.NewInt(1)) != 0 {
+		switch {
+		case m.Mod(d, two).Sign() == 0:
+			d.Quo(d, two)
+			twos++
+		case m.Mod(d, five).Sign() == 0:
+			d.Quo(d, five)
+			fives++
+		default:
+			return 0, false
+		}
+	}
+	return max(twos, fives), true
+}
+
+// round rounds r half to even at scale decimal places.
+func round(r *big.Rat, scale int) *big.Rat {
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow))
+	q, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	twice := new(big.Int).Lsh(new(big.Int).Abs(rem), 1)
+	if c := twice.Cmp(scaled.Denom()); c > 0 || c == 0 && q.Bit(0) == 1 {
+		q.Add(q, big.NewInt(int64(rem.Sign())))
+	}
+	return new(big.Rat).SetFrac(q, pow)
+}
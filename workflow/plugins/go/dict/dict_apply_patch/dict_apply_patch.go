@@ -0,0 +1,325 @@
+// Package dict_apply_patch provides a workflow plugin for patching dictionaries.
+package dict_apply_patch
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictApplyPatch implements the NodeExecutor interface for patching dictionaries.
+type DictApplyPatch struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictApplyPatch creates a new DictApplyPatch instance.
+func NewDictApplyPatch() *DictApplyPatch {
+	return &DictApplyPatch{
+		NodeType:    "dict.apply_patch",
+		Category:    "dict",
+		Description: "Apply a JSON Patch or JSON Merge Patch to a dictionary",
+	}
+}
+
+// Execute runs the plugin logic.
+// Applies a patch to a dictionary. A list is an RFC 6902 JSON Patch
+// (add, remove, replace, move, copy and test operations, as produced by
+// dict.diff with json_patch); an object is an RFC 7386 JSON Merge Patch,
+// where null removes a key. The input dictionary is not modified.
+// Inputs:
+//   - dict: the dictionary to patch
+//   - patch: list of JSON Patch operations, or a merge patch object
+//   - atomic: (optional) for JSON Patch, discard every change when an operation fails (default: true)
+//
+// Returns:
+//   - result: the patched dictionary, or the original one when an atomic patch failed
+//   - success: whether every operation was applied
+//   - operations: list of {op, path, success, error} for each JSON Patch operation
+//   - error: set when the patch is invalid or an atomic patch failed
+func (p *DictApplyPatch) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		dict = map[string]interface{}{}
+	}
+	atomic := true
+	if v, ok := inputs["atomic"].(bool); ok {
+		atomic = v
+	}
+
+	switch patch := inputs["patch"].(type) {
+	case map[string]interface{}:
+		return map[string]interface{}{"result": mergePatch(dict, patch), "success": true, "operations": []interface{}{}}
+	case []interface{}:
+		return applyJSONPatch(dict, patch, atomic)
+	default:
+		return map[string]interface{}{"result": dict, "success": false, "operations": []interface{}{}, "error": "patch must be a list of operations or a merge patch object"}
+	}
+}
+
+// mergePatch applies an RFC 7386 merge patch.
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, _ := target.(map[string]interface{})
+	result := make(map[string]interface{}, len(t)+len(p))
+	for k, v := range t {
+		result[k] = v
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(result, k)
+		} else {
+			result[k] = mergePatch(result[k], v)
+		}
+	}
+	return result
+}
+
+func applyJSONPatch(dict map[string]interface{}, patch []interface{}, atomic bool) map[string]interface{} {
+	var root interface{} = dict
+	operations := make([]interface{}, 0, len(patch))
+	success := true
+	var firstErr string
+
+	for i, raw := range patch {
+		op, _ := raw.(map[string]interface{})
+		name, _ := op["op"].(string)
+		pointer, _ := op["path"].(string)
+		report := map[string]interface{}{"op": name, "path": pointer, "success": true}
+		operations = append(operations, report)
+
+		if atomic && !success {
+			report["success"] = false
+			report["error"] = "not applied: an earlier operation failed"
+			continue
+		}
+
+		next, err := applyOperation(root, op)
+		if err != nil {
+			report["success"] = false
+			report["error"] = err.Error()
+			if success {
+				firstErr = fmt.Sprintf("operation %d (%s %s): %v", i, name, pointer, err)
+			}
+			success = false
+			continue
+		}
+		if _, isMap := next.(map[string]interface{}); !isMap {
+			report["success"] = false
+			report["error"] = "the result must remain a dictionary"
+			if success {
+				firstErr = fmt.Sprintf("operation %d (%s %s): the result must remain a dictionary", i, name, pointer)
+			}
+			success = false
+			continue
+		}
+		root = next
+	}
+
+	if atomic && !success {
+		return map[string]interface{}{"result": dict, "success": false, "operations": operations, "error": firstErr}
+	}
+	return map[string]interface{}{"result": root, "success": success, "operations": operations}
+}
+
+// applyOperation applies one JSON Patch operation and returns the new root.
+func applyOperation(root interface{}, op map[string]interface{}) (interface{}, error) {
+	if op == nil {
+		return nil, fmt.Errorf("operation must be an object")
+	}
+	name, _ := op["op"].(string)
+	pointer, ok := op["path"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing path")
+	}
+	path, err := paths.ParsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case "add", "replace", "test":
+		value, ok := op["value"]
+		if !ok {
+			return nil, fmt.Errorf("missing value")
+		}
+		switch name {
+		case "add":
+			return add(root, path, value)
+		case "replace":
+			if _, found := paths.Get(root, path); !found {
+				return nil, fmt.Errorf("path not found")
+			}
+			return paths.Set(root, path, value)
+		default:
+			current, found := paths.Get(root, path)
+			if !found {
+				return nil, fmt.Errorf("path not found")
+			}
+			if !equal(current, value) {
+				return nil, fmt.Errorf("test failed")
+			}
+			return root, nil
+		}
+	case "remove":
+		return remove(root, path)
+	case "move", "copy":
+		fromPointer, ok := op["from"].(string)
+		if !ok {
+			return nil, fmt.Errorf("missing from")
+		}
+		from, err := paths.ParsePointer(fromPointer)
+		if err != nil {
+			return nil, err
+		}
+		value, found := paths.Get(root, from)
+		if !found {
+			return nil, fmt.Errorf("from path not found")
+		}
+		if name == "move" {
+			if len(from) < len(path) && paths.Path(path[:len(from)]).Pointer() == fromPointer {
+				return nil, fmt.Errorf("cannot move a value into itself")
+			}
+			if root, err = remove(root, from); err != nil {
+				return nil, err
+			}
+		}
+		return add(root, path, value)
+	default:
+		return nil, fmt.Errorf("unknown op %q", name)
+	}
+}
+
+// add inserts value at path. The parent must exist; list positions shift
+// and "-" appends.
+func add(root interface{}, path paths.Path, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parentPath, token := path[:len(path)-1], path[len(path)-1].Key
+	parent, found := paths.Get(root, parentPath)
+	if !found {
+		return nil, fmt.Errorf("parent path not found")
+	}
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(p)+1)
+		for k, v := range p {
+			result[k] = v
+		}
+		result[token] = value
+		return paths.Set(root, parentPath, result)
+	case []interface{}:
+		idx := len(p)
+		if token != "-" {
+			var err error
+			if idx, err = arrayIndex(token, len(p)+1); err != nil {
+				return nil, err
+			}
+		}
+		result := make([]interface{}, 0, len(p)+1)
+		result = append(result, p[:idx]...)
+		result = append(result, value)
+		result = append(result, p[idx:]...)
+		return paths.Set(root, parentPath, result)
+	default:
+		return nil, fmt.Errorf("parent is not a dictionary or list")
+	}
+}
+
+// remove deletes the value at path, which must exist.
+func remove(root interface{}, path paths.Path) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("cannot remove the whole document")
+	}
+	parentPath, token := path[:len(path)-1], path[len(path)-1].Key
+	parent, found := paths.Get(root, parentPath)
+	if !found {
+		return nil, fmt.Errorf("path not found")
+	}
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		if _, exists := p[token]; !exists {
+			return nil, fmt.Errorf("path not found")
+		}
+	case []interface{}:
+		if _, err := arrayIndex(token, len(p)); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("path not found")
+	}
+	result, _ := paths.Delete(root, path)
+	return result, nil
+}
+
+// arrayIndex parses an RFC 6901 array index, which must be below limit.
+func arrayIndex(token string, limit int) (int, error) {
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if idx >= limit {
+		return 0, fmt.Errorf("array index %d out of range", idx)
+	}
+	return idx, nil
+}
+
+// equal compares values for the test operation, treating all numeric types
+// alike.
+func equal(a, b interface{}) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			if other, exists := bv[k]; !exists || !equal(v, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !equal(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
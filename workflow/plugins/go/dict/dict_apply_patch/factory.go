@@ -0,0 +1,7 @@
+// Package dict_apply_patch provides factory for DictApplyPatch plugin.
+package dict_apply_patch
+
+// Create returns a new DictApplyPatch instance.
+func Create() *DictApplyPatch {
+	return NewDictApplyPatch()
+}
@@ -0,0 +1,188 @@
+// Package dict_deep_equal provides a workflow plugin for deep dictionary comparison.
+package dict_deep_equal
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictDeepEqual implements the NodeExecutor interface for deep dictionary comparison.
+type DictDeepEqual struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictDeepEqual creates a new DictDeepEqual instance.
+func NewDictDeepEqual() *DictDeepEqual {
+	return &DictDeepEqual{
+		NodeType:    "dict.deep_equal",
+		Category:    "dict",
+		Description: "Deeply compare two dictionaries",
+	}
+}
+
+// Execute runs the plugin logic.
+// Compares two dictionaries recursively. Key order never matters. Unlike
+// logic.equals, numbers compare by value by default, so 1 from Go code
+// equals 1.0 decoded from JSON. Keys are visited in sorted order, so the
+// reported difference is deterministic.
+// Inputs:
+//   - a: the first dictionary
+//   - b: the second dictionary
+//   - ignore_paths: (optional) list of paths to leave out of the comparison, in dict.get syntax with wildcards
+//   - ignore_list_order: (optional) compare lists as unordered collections (default: false)
+//   - strict_numbers: (optional) treat int and float values as different types (default: false)
+//
+// Returns:
+//   - result: whether the dictionaries are equal
+//   - difference: path of the first difference, or null when equal
+//   - error: set when an ignore path is invalid or empty
+func (p *DictDeepEqual) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	a, _ := inputs["a"].(map[string]interface{})
+	b, _ := inputs["b"].(map[string]interface{})
+	if a == nil {
+		a = map[string]interface{}{}
+	}
+	if b == nil {
+		b = map[string]interface{}{}
+	}
+
+	if list, ok := inputs["ignore_paths"].([]interface{}); ok {
+		ignored := make([]paths.Path, 0, len(list))
+		for _, s := range list {
+			path, err := paths.Parse(fmt.Sprintf("%v", s))
+			if err != nil {
+				return map[string]interface{}{"result": false, "difference": nil, "error": err.Error()}
+			}
+			if len(path) == 0 {
+				return map[string]interface{}{"result": false, "difference": nil, "error": "ignore path must not be empty"}
+			}
+			ignored = append(ignored, path)
+		}
+		a = paths.Omit(a, ignored...).(map[string]interface{})
+		b = paths.Omit(b, ignored...).(map[string]interface{})
+	}
+
+	c := comparer{}
+	c.unordered, _ = inputs["ignore_list_order"].(bool)
+	c.strict, _ = inputs["strict_numbers"].(bool)
+
+	if diff, found := c.compare(nil, a, b); found {
+		return map[string]interface{}{"result": false, "difference": diff.String()}
+	}
+	return map[string]interface{}{"result": true, "difference": nil}
+}
+
+type comparer struct {
+	unordered bool
+	strict    bool
+}
+
+// compare returns the path of the first difference between a and b.
+func (c comparer) compare(path paths.Path, a, b interface{}) (paths.Path, bool) {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return path, true
+		}
+		keys := make([]string, 0, len(av)+len(bv))
+		for k := range av {
+			keys = append(keys, k)
+		}
+		for k := range bv {
+			if _, exists := av[k]; !exists {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := append(path[:len(path):len(path)], paths.Segment{Key: k})
+			x, inA := av[k]
+			y, inB := bv[k]
+			if !inA || !inB {
+				return child, true
+			}
+			if diff, found := c.compare(child, x, y); found {
+				return diff, true
+			}
+		}
+		return nil, false
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return path, true
+		}
+		if c.unordered {
+			if !c.sameElements(av, bv) {
+				return path, true
+			}
+			return nil, false
+		}
+		for i := range av {
+			child := append(path[:len(path):len(path)], paths.Segment{Index: i, IsIndex: true})
+			if diff, found := c.compare(child, av[i], bv[i]); found {
+				return diff, true
+			}
+		}
+		return nil, false
+	}
+
+	if !c.strict {
+		if fa, ok := toFloat(a); ok {
+			fb, ok := toFloat(b)
+			if !ok || fa != fb {
+				return path, true
+			}
+			return nil, false
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		return path, true
+	}
+	return nil, false
+}
+
+// sameElements reports whether two lists of equal length hold the same
+// elements in any order.
+func (c comparer) sameElements(a, b []interface{}) bool {
+	used := make([]bool, len(b))
+	for _, x := range a {
+		matched := false
+		for j, y := range b {
+			if used[j] {
+				continue
+			}
+			if _, found := c.compare(nil, x, y); !found {
+				used[j] = true
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
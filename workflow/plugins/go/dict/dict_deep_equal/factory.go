@@ -0,0 +1,7 @@
+// Package dict_deep_equal provides factory for DictDeepEqual plugin.
+package dict_deep_equal
+
+// Create returns a new DictDeepEqual instance.
+func Create() *DictDeepEqual {
+	return NewDictDeepEqual()
+}
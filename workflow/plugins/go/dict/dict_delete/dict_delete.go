@@ -2,7 +2,7 @@
 package dict_delete
 
 import (
-	"strings"
+	"github.com/metabuilder/workflow-plugins-go/paths"
 )
 
 // DictDelete implements the NodeExecutor interface for deleting dictionary keys.
@@ -23,7 +23,11 @@ func NewDictDelete() *DictDelete {
 
 // Execute runs the plugin logic.
 // Removes a key from a dictionary.
-// Supports dot notation for nested keys (e.g., "user.name").
+// Supports dot notation for nested keys (e.g., "user.name"), list indices
+// (e.g., "items[0]"), backslash escapes for keys containing dots
+// (e.g., "a\.b") and wildcards that delete every match
+// (e.g., "users.*.password"). See the paths package for the full syntax.
+// The input dictionary is not modified.
 // Inputs:
 //   - dict: the dictionary to modify
 //   - key: the key to delete (supports dot notation)
@@ -31,67 +35,23 @@ func NewDictDelete() *DictDelete {
 // Returns:
 //   - result: the modified dictionary
 //   - deleted: whether the key was found and deleted
+//   - error: set when the key is not a valid path
 func (p *DictDelete) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	dict, ok := inputs["dict"].(map[string]interface{})
 	if !ok {
 		return map[string]interface{}{"result": map[string]interface{}{}, "deleted": false}
 	}
 
-	// Make a shallow copy to avoid mutating the original
-	dict = copyDict(dict)
-
 	key, ok := inputs["key"].(string)
 	if !ok {
 		return map[string]interface{}{"result": dict, "deleted": false}
 	}
 
-	// Handle dot notation for nested keys
-	parts := strings.Split(key, ".")
-
-	if len(parts) == 1 {
-		// Simple key
-		if _, exists := dict[key]; exists {
-			delete(dict, key)
-			return map[string]interface{}{"result": dict, "deleted": true}
-		}
-		return map[string]interface{}{"result": dict, "deleted": false}
-	}
-
-	// Nested key - navigate to parent and delete
-	current := dict
-	for i := 0; i < len(parts)-1; i++ {
-		part := parts[i]
-		if val, exists := current[part]; exists {
-			if nested, ok := val.(map[string]interface{}); ok {
-				// Copy nested dict to avoid mutation
-				copied := copyDict(nested)
-				current[part] = copied
-				current = copied
-			} else {
-				// Cannot descend further
-				return map[string]interface{}{"result": dict, "deleted": false}
-			}
-		} else {
-			// Path does not exist
-			return map[string]interface{}{"result": dict, "deleted": false}
-		}
+	path, err := paths.Parse(key)
+	if err != nil {
+		return map[string]interface{}{"result": dict, "deleted": false, "error": err.Error()}
 	}
 
-	// Delete the final key
-	finalKey := parts[len(parts)-1]
-	if _, exists := current[finalKey]; exists {
-		delete(current, finalKey)
-		return map[string]interface{}{"result": dict, "deleted": true}
-	}
-
-	return map[string]interface{}{"result": dict, "deleted": false}
-}
-
-// copyDict creates a shallow copy of a dictionary.
-func copyDict(d map[string]interface{}) map[string]interface{} {
-	result := make(map[string]interface{}, len(d))
-	for k, v := range d {
-		result[k] = v
-	}
-	return result
+	result, deleted := paths.Delete(dict, path)
+	return map[string]interface{}{"result": result, "deleted": deleted}
 }
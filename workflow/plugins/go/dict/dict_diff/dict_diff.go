@@ -0,0 +1,171 @@
+// Package dict_diff provides a workflow plugin for comparing dictionaries.
+package dict_diff
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictDiff implements the NodeExecutor interface for comparing dictionaries.
+type DictDiff struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictDiff creates a new DictDiff instance.
+func NewDictDiff() *DictDiff {
+	return &DictDiff{
+		NodeType:    "dict.diff",
+		Category:    "dict",
+		Description: "Compare two dictionaries",
+	}
+}
+
+// Execute runs the plugin logic.
+// Compares two dictionaries recursively. Nested dictionaries are compared
+// key by key and lists element by element; numbers compare by value, so 1
+// equals 1.0. Each change is an object with a path (in dict.get syntax)
+// and the values involved; changes are ordered by path.
+// Inputs:
+//   - old: the original dictionary
+//   - new: the updated dictionary
+//   - json_patch: (optional) also return the changes as an RFC 6902 JSON Patch (default: false)
+//
+// Returns:
+//   - added: list of {path, value} for keys only in new
+//   - removed: list of {path, value} for keys only in old
+//   - changed: list of {path, old, new} for values that differ
+//   - equal: whether the dictionaries are equal
+//   - patch: (optional) list of JSON Patch operations turning old into new, when json_patch is set
+func (p *DictDiff) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	oldDict, _ := inputs["old"].(map[string]interface{})
+	newDict, _ := inputs["new"].(map[string]interface{})
+	if oldDict == nil {
+		oldDict = map[string]interface{}{}
+	}
+	if newDict == nil {
+		newDict = map[string]interface{}{}
+	}
+
+	d := &differ{added: []interface{}{}, removed: []interface{}{}, changed: []interface{}{}, patch: []interface{}{}}
+	d.diff(nil, oldDict, newDict)
+
+	result := map[string]interface{}{
+		"added":   d.added,
+		"removed": d.removed,
+		"changed": d.changed,
+		"equal":   len(d.added)+len(d.removed)+len(d.changed) == 0,
+	}
+	if asPatch, ok := inputs["json_patch"].(bool); ok && asPatch {
+		result["patch"] = d.patch
+	}
+	return result
+}
+
+// differ collects changes in both output formats during one walk.
+type differ struct {
+	added, removed, changed, patch []interface{}
+}
+
+func (d *differ) diff(path paths.Path, oldVal, newVal interface{}) {
+	switch o := oldVal.(type) {
+	case map[string]interface{}:
+		if n, ok := newVal.(map[string]interface{}); ok {
+			d.diffMaps(path, o, n)
+			return
+		}
+	case []interface{}:
+		if n, ok := newVal.([]interface{}); ok {
+			d.diffLists(path, o, n)
+			return
+		}
+	}
+	if !equal(oldVal, newVal) {
+		d.changed = append(d.changed, map[string]interface{}{"path": path.String(), "old": oldVal, "new": newVal})
+		d.patch = append(d.patch, map[string]interface{}{"op": "replace", "path": path.Pointer(), "value": newVal})
+	}
+}
+
+func (d *differ) diffMaps(path paths.Path, o, n map[string]interface{}) {
+	keys := make([]string, 0, len(o)+len(n))
+	for k := range o {
+		keys = append(keys, k)
+	}
+	for k := range n {
+		if _, ok := o[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		child := append(path[:len(path):len(path)], paths.Segment{Key: k})
+		oldVal, inOld := o[k]
+		newVal, inNew := n[k]
+		switch {
+		case !inNew:
+			d.remove(child, oldVal)
+		case !inOld:
+			d.add(child, child.Pointer(), newVal)
+		default:
+			d.diff(child, oldVal, newVal)
+		}
+	}
+}
+
+func (d *differ) diffLists(path paths.Path, o, n []interface{}) {
+	common := len(o)
+	if len(n) < common {
+		common = len(n)
+	}
+	for i := 0; i < common; i++ {
+		d.diff(append(path[:len(path):len(path)], paths.Segment{Index: i, IsIndex: true}), o[i], n[i])
+	}
+	for i := common; i < len(n); i++ {
+		child := append(path[:len(path):len(path)], paths.Segment{Index: i, IsIndex: true})
+		d.add(child, path.Pointer()+"/-", n[i])
+	}
+	// Remove from the end so the patch's indices stay valid
+	for i := len(o) - 1; i >= common; i-- {
+		d.remove(append(path[:len(path):len(path)], paths.Segment{Index: i, IsIndex: true}), o[i])
+	}
+}
+
+func (d *differ) add(path paths.Path, pointer string, value interface{}) {
+	d.added = append(d.added, map[string]interface{}{"path": path.String(), "value": value})
+	d.patch = append(d.patch, map[string]interface{}{"op": "add", "path": pointer, "value": value})
+}
+
+func (d *differ) remove(path paths.Path, value interface{}) {
+	d.removed = append(d.removed, map[string]interface{}{"path": path.String(), "value": value})
+	d.patch = append(d.patch, map[string]interface{}{"op": "remove", "path": path.Pointer()})
+}
+
+// equal compares two leaf values, treating all numeric types alike.
+func equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
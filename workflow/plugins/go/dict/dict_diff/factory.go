@@ -0,0 +1,7 @@
+// Package dict_diff provides factory for DictDiff plugin.
+package dict_diff
+
+// Create returns a new DictDiff instance.
+func Create() *DictDiff {
+	return NewDictDiff()
+}
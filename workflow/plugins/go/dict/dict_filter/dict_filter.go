@@ -0,0 +1,178 @@
+// Package dict_filter provides a workflow plugin for filtering dictionary entries.
+package dict_filter
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DictFilter implements the NodeExecutor interface for filtering dictionary entries.
+type DictFilter struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictFilter creates a new DictFilter instance.
+func NewDictFilter() *DictFilter {
+	return &DictFilter{
+		NodeType:    "dict.filter",
+		Category:    "dict",
+		Description: "Keep dictionary entries matching key or value conditions",
+	}
+}
+
+// Execute runs the plugin logic.
+// Builds a new dictionary from the entries that satisfy every given
+// condition. Key conditions match the key text; the value condition
+// compares each value with the value input. Numbers compare numerically and
+// strings lexically; ordering conditions never match other types.
+// Inputs:
+//   - dict: the dictionary to filter
+//   - key_prefix: (optional) keep keys starting with this string
+//   - key_suffix: (optional) keep keys ending with this string
+//   - key_pattern: (optional) keep keys matching this regular expression
+//   - condition: (optional) value condition: equals, not_equals, gt, gte, lt, lte, null, not_null
+//   - value: (optional) operand for equals, not_equals, gt, gte, lt and lte
+//   - invert: (optional) keep the entries that do not match instead (default: false)
+//
+// Returns:
+//   - result: the filtered dictionary
+//   - removed: keys that were filtered out, sorted
+//   - error: set when key_pattern or condition is invalid, or value is missing for a condition that needs it
+func (p *DictFilter) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"result": map[string]interface{}{}, "removed": []interface{}{}}
+	}
+
+	prefix, _ := inputs["key_prefix"].(string)
+	suffix, _ := inputs["key_suffix"].(string)
+	var pattern *regexp.Regexp
+	if s, ok := inputs["key_pattern"].(string); ok && s != "" {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return map[string]interface{}{"result": map[string]interface{}{}, "removed": []interface{}{}, "error": fmt.Sprintf("invalid key_pattern: %v", err)}
+		}
+		pattern = re
+	}
+	condition, _ := inputs["condition"].(string)
+	switch condition {
+	case "", "equals", "not_equals", "gt", "gte", "lt", "lte", "null", "not_null":
+	default:
+		return map[string]interface{}{"result": map[string]interface{}{}, "removed": []interface{}{}, "error": fmt.Sprintf("unknown condition %q", condition)}
+	}
+	operand := inputs["value"]
+	if operand == nil && condition != "" && condition != "null" && condition != "not_null" {
+		return map[string]interface{}{"result": map[string]interface{}{}, "removed": []interface{}{}, "error": fmt.Sprintf("value is required for condition %q", condition)}
+	}
+	invert, _ := inputs["invert"].(bool)
+
+	result := make(map[string]interface{}, len(dict))
+	removed := []string{}
+	for k, v := range dict {
+		keep := strings.HasPrefix(k, prefix) &&
+			strings.HasSuffix(k, suffix) &&
+			(pattern == nil || pattern.MatchString(k)) &&
+			matches(condition, v, operand)
+		if keep != invert {
+			result[k] = v
+		} else {
+			removed = append(removed, k)
+		}
+	}
+
+	sort.Strings(removed)
+	removedList := make([]interface{}, len(removed))
+	for i, k := range removed {
+		removedList[i] = k
+	}
+
+	return map[string]interface{}{"result": result, "removed": removedList}
+}
+
+// matches applies a value condition. The empty condition matches everything.
+func matches(condition string, value, operand interface{}) bool {
+	switch condition {
+	case "":
+		return true
+	case "null":
+		return value == nil
+	case "not_null":
+		return value != nil
+	case "equals":
+		return equal(value, operand)
+	case "not_equals":
+		return !equal(value, operand)
+	}
+
+	cmp, ok := compare(value, operand)
+	if !ok {
+		return false
+	}
+	switch condition {
+	case "gt":
+		return cmp > 0
+	case "gte":
+		return cmp >= 0
+	case "lt":
+		return cmp < 0
+	default:
+		return cmp <= 0
+	}
+}
+
+// compare orders two numbers or two strings.
+func compare(a, b interface{}) (int, bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	sa, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	sb, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(sa, sb), true
+}
+
+func equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,7 @@
+// Package dict_filter provides factory for DictFilter plugin.
+package dict_filter
+
+// Create returns a new DictFilter instance.
+func Create() *DictFilter {
+	return NewDictFilter()
+}
@@ -0,0 +1,76 @@
+// Package dict_from_entries provides a workflow plugin for building dictionaries from entry lists.
+package dict_from_entries
+
+import (
+	"fmt"
+)
+
+// DictFromEntries implements the NodeExecutor interface for building dictionaries from entry lists.
+type DictFromEntries struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictFromEntries creates a new DictFromEntries instance.
+func NewDictFromEntries() *DictFromEntries {
+	return &DictFromEntries{
+		NodeType:    "dict.from_entries",
+		Category:    "dict",
+		Description: "Build a dictionary from a list of key/value entries",
+	}
+}
+
+// Execute runs the plugin logic.
+// Builds a dictionary from a list of {key, value} objects, as produced by
+// dict.to_entries, or from [key, value] pairs. Non-string keys are
+// formatted as strings. Later entries overwrite earlier ones with the same
+// key.
+// Inputs:
+//   - entries: list of entries
+//   - key_field: (optional) name of the key field in each entry (default: "key")
+//   - value_field: (optional) name of the value field in each entry (default: "value")
+//
+// Returns:
+//   - result: the dictionary
+//   - error: set when an entry has no key
+func (p *DictFromEntries) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	entries, ok := inputs["entries"].([]interface{})
+	if !ok {
+		return map[string]interface{}{"result": map[string]interface{}{}}
+	}
+	keyField, _ := inputs["key_field"].(string)
+	if keyField == "" {
+		keyField = "key"
+	}
+	valueField, _ := inputs["value_field"].(string)
+	if valueField == "" {
+		valueField = "value"
+	}
+
+	result := make(map[string]interface{}, len(entries))
+	for i, e := range entries {
+		var key, value interface{}
+		switch entry := e.(type) {
+		case map[string]interface{}:
+			k, exists := entry[keyField]
+			if !exists {
+				return map[string]interface{}{"result": map[string]interface{}{}, "error": fmt.Sprintf("entry %d has no %q field", i, keyField)}
+			}
+			key, value = k, entry[valueField]
+		case []interface{}:
+			if len(entry) != 2 {
+				return map[string]interface{}{"result": map[string]interface{}{}, "error": fmt.Sprintf("entry %d must be a [key, value] pair", i)}
+			}
+			key, value = entry[0], entry[1]
+		default:
+			return map[string]interface{}{"result": map[string]interface{}{}, "error": fmt.Sprintf("entry %d must be an object or a [key, value] pair", i)}
+		}
+		if key == nil {
+			return map[string]interface{}{"result": map[string]interface{}{}, "error": fmt.Sprintf("entry %d has a null key", i)}
+		}
+		result[fmt.Sprintf("%v", key)] = value
+	}
+
+	return map[string]interface{}{"result": result}
+}
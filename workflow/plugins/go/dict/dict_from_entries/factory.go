@@ -0,0 +1,7 @@
+// Package dict_from_entries provides factory for DictFromEntries plugin.
+package dict_from_entries
+
+// Create returns a new DictFromEntries instance.
+func Create() *DictFromEntries {
+	return NewDictFromEntries()
+}
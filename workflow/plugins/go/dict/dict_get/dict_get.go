@@ -2,7 +2,7 @@
 package dict_get
 
 import (
-	"strings"
+	"github.com/metabuilder/workflow-plugins-go/paths"
 )
 
 // DictGet implements the NodeExecutor interface for getting dictionary values.
@@ -23,7 +23,9 @@ func NewDictGet() *DictGet {
 
 // Execute runs the plugin logic.
 // Retrieves a value from a dictionary by key.
-// Supports dot notation for nested keys (e.g., "user.name").
+// Supports dot notation for nested keys (e.g., "user.name"), list indices
+// (e.g., "items[0].name") and backslash escapes for keys containing dots
+// (e.g., "a\.b"). See the paths package for the full syntax.
 // Inputs:
 //   - dict: the dictionary to read from
 //   - key: the key to retrieve (supports dot notation)
@@ -32,44 +34,31 @@ func NewDictGet() *DictGet {
 // Returns:
 //   - result: the value at the key or default
 //   - found: whether the key was found
+//   - error: set when the key is not a valid path or contains a wildcard
 func (p *DictGet) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	defaultVal := inputs["default"]
+
 	dict, ok := inputs["dict"].(map[string]interface{})
 	if !ok {
-		defaultVal := inputs["default"]
 		return map[string]interface{}{"result": defaultVal, "found": false}
 	}
 
+	// An empty path would address the dictionary itself
 	key, ok := inputs["key"].(string)
-	if !ok {
-		defaultVal := inputs["default"]
+	if !ok || key == "" {
 		return map[string]interface{}{"result": defaultVal, "found": false}
 	}
 
-	// Handle dot notation for nested keys
-	parts := strings.Split(key, ".")
-	current := dict
-
-	for i, part := range parts {
-		if val, exists := current[part]; exists {
-			if i == len(parts)-1 {
-				// Final key, return the value
-				return map[string]interface{}{"result": val, "found": true}
-			}
-			// Not the final key, try to descend
-			if nested, ok := val.(map[string]interface{}); ok {
-				current = nested
-			} else {
-				// Cannot descend further
-				defaultVal := inputs["default"]
-				return map[string]interface{}{"result": defaultVal, "found": false}
-			}
-		} else {
-			// Key not found
-			defaultVal := inputs["default"]
-			return map[string]interface{}{"result": defaultVal, "found": false}
-		}
+	path, err := paths.Parse(key)
+	if err != nil {
+		return map[string]interface{}{"result": defaultVal, "found": false, "error": err.Error()}
+	}
+	if path.HasWildcard() {
+		return map[string]interface{}{"result": defaultVal, "found": false, "error": "dict.get returns a single value; use dict.query for wildcards and filters"}
 	}
 
-	defaultVal := inputs["default"]
+	if val, found := paths.Get(dict, path); found {
+		return map[string]interface{}{"result": val, "found": true}
+	}
 	return map[string]interface{}{"result": defaultVal, "found": false}
 }
@@ -0,0 +1,56 @@
+// Package dict_has_key provides a workflow plugin for checking dictionary keys.
+package dict_has_key
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictHasKey implements the NodeExecutor interface for checking dictionary keys.
+type DictHasKey struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictHasKey creates a new DictHasKey instance.
+func NewDictHasKey() *DictHasKey {
+	return &DictHasKey{
+		NodeType:    "dict.has_key",
+		Category:    "dict",
+		Description: "Check whether a dictionary contains a key",
+	}
+}
+
+// Execute runs the plugin logic.
+// Reports whether a key exists without retrieving its value, so a key
+// holding null counts as present. Keys use the same path syntax as dict.get.
+// Inputs:
+//   - dict: the dictionary to check
+//   - key: the key to look for (supports dot notation)
+//
+// Returns:
+//   - found: whether the key exists
+//   - depth: number of path segments that resolved; equals the path length when found
+//   - error: set when the key is not a valid path or contains a wildcard
+func (p *DictHasKey) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"found": false, "depth": 0}
+	}
+
+	key, ok := inputs["key"].(string)
+	if !ok {
+		return map[string]interface{}{"found": false, "depth": 0}
+	}
+
+	path, err := paths.Parse(key)
+	if err != nil {
+		return map[string]interface{}{"found": false, "depth": 0, "error": err.Error()}
+	}
+	if path.HasWildcard() {
+		return map[string]interface{}{"found": false, "depth": 0, "error": "wildcards are not supported by dict.has_key"}
+	}
+
+	_, depth, found := paths.Resolve(dict, path)
+	return map[string]interface{}{"found": found, "depth": depth}
+}
@@ -0,0 +1,7 @@
+// Package dict_has_key provides factory for DictHasKey plugin.
+package dict_has_key
+
+// Create returns a new DictHasKey instance.
+func Create() *DictHasKey {
+	return NewDictHasKey()
+}
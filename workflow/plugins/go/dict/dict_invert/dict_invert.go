@@ -0,0 +1,108 @@
+// Package dict_invert provides a workflow plugin for inverting dictionaries.
+package dict_invert
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// DictInvert implements the NodeExecutor interface for inverting dictionaries.
+type DictInvert struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictInvert creates a new DictInvert instance.
+func NewDictInvert() *DictInvert {
+	return &DictInvert{
+		NodeType:    "dict.invert",
+		Category:    "dict",
+		Description: "Swap the keys and values of a dictionary",
+	}
+}
+
+// Execute runs the plugin logic.
+// Swaps keys and values, e.g. for building reverse lookup tables.
+// Values become keys the way convert.to_string formats them: strings as is,
+// null as "", everything else JSON encoded (so 1 becomes "1"). Keys are
+// visited in sorted order, so "first" and "last" are deterministic.
+// Inputs:
+//   - dict: the dictionary to invert
+//   - on_collision: (optional) what to do when several keys share a value: "last" (default) keeps the last key, "first" keeps the first, "collect" maps every value to a list of keys
+//
+// Returns:
+//   - result: the inverted dictionary
+//   - collisions: values that more than one key mapped to, sorted
+//   - error: set when on_collision is unknown
+func (p *DictInvert) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"result": map[string]interface{}{}, "collisions": []interface{}{}}
+	}
+
+	strategy, _ := inputs["on_collision"].(string)
+	switch strategy {
+	case "":
+		strategy = "last"
+	case "first", "last", "collect":
+	default:
+		return map[string]interface{}{"result": map[string]interface{}{}, "collisions": []interface{}{}, "error": fmt.Sprintf("unknown on_collision %q", strategy)}
+	}
+
+	keys := make([]string, 0, len(dict))
+	for k := range dict {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make(map[string]interface{}, len(dict))
+	counts := make(map[string]int, len(dict))
+	for _, k := range keys {
+		v := stringify(dict[k])
+		counts[v]++
+		switch strategy {
+		case "first":
+			if _, exists := result[v]; !exists {
+				result[v] = k
+			}
+		case "last":
+			result[v] = k
+		case "collect":
+			list, _ := result[v].([]interface{})
+			result[v] = append(list, k)
+		}
+	}
+
+	collisions := []string{}
+	for v, n := range counts {
+		if n > 1 {
+			collisions = append(collisions, v)
+		}
+	}
+	sort.Strings(collisions)
+	list := make([]interface{}, len(collisions))
+	for i, v := range collisions {
+		list[i] = v
+	}
+
+	return map[string]interface{}{"result": result, "collisions": list}
+}
+
+// stringify formats a value as a dictionary key, matching convert.to_string.
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case nil:
+		return ""
+	default:
+		if bytes, err := json.Marshal(v); err == nil {
+			return string(bytes)
+		}
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,7 @@
+// Package dict_invert provides factory for DictInvert plugin.
+package dict_invert
+
+// Create returns a new DictInvert instance.
+func Create() *DictInvert {
+	return NewDictInvert()
+}
@@ -0,0 +1,76 @@
+// Package dict_omit provides a workflow plugin for removing dictionary keys.
+package dict_omit
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictOmit implements the NodeExecutor interface for removing dictionary keys.
+type DictOmit struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictOmit creates a new DictOmit instance.
+func NewDictOmit() *DictOmit {
+	return &DictOmit{
+		NodeType:    "dict.omit",
+		Category:    "dict",
+		Description: "Copy a dictionary without selected keys",
+	}
+}
+
+// Execute runs the plugin logic.
+// Copies a dictionary without the listed keys. With nested paths, keys such
+// as "user.password" or "users.*.token" remove values below the top level.
+// List indices refer to the original positions, so ["items[0]", "items[1]"]
+// removes the first two elements. The input dictionary is not modified.
+// Inputs:
+//   - dict: the dictionary to copy
+//   - keys: list of keys to remove
+//   - nested: (optional) treat keys as paths like dict.delete, default true; when false keys are matched literally
+//
+// Returns:
+//   - result: the dictionary without the keys
+//   - error: set when a key is empty or not a valid path
+func (p *DictOmit) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"result": map[string]interface{}{}}
+	}
+	keys, _ := inputs["keys"].([]interface{})
+	nested := true
+	if v, ok := inputs["nested"].(bool); ok {
+		nested = v
+	}
+
+	if !nested {
+		omit := make(map[string]bool, len(keys))
+		for _, k := range keys {
+			omit[fmt.Sprintf("%v", k)] = true
+		}
+		result := make(map[string]interface{}, len(dict))
+		for k, v := range dict {
+			if !omit[k] {
+				result[k] = v
+			}
+		}
+		return map[string]interface{}{"result": result}
+	}
+
+	omitted := make([]paths.Path, 0, len(keys))
+	for _, k := range keys {
+		path, err := paths.Parse(fmt.Sprintf("%v", k))
+		if err != nil {
+			return map[string]interface{}{"result": dict, "error": err.Error()}
+		}
+		if len(path) == 0 {
+			return map[string]interface{}{"result": dict, "error": "key must not be empty"}
+		}
+		omitted = append(omitted, path)
+	}
+	return map[string]interface{}{"result": paths.Omit(dict, omitted...)}
+}
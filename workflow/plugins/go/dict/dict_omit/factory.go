@@ -0,0 +1,7 @@
+// Package dict_omit provides factory for DictOmit plugin.
+package dict_omit
+
+// Create returns a new DictOmit instance.
+func Create() *DictOmit {
+	return NewDictOmit()
+}
@@ -0,0 +1,86 @@
+// Package dict_pick provides a workflow plugin for selecting dictionary keys.
+package dict_pick
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictPick implements the NodeExecutor interface for selecting dictionary keys.
+type DictPick struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictPick creates a new DictPick instance.
+func NewDictPick() *DictPick {
+	return &DictPick{
+		NodeType:    "dict.pick",
+		Category:    "dict",
+		Description: "Build a dictionary from selected keys",
+	}
+}
+
+// Execute runs the plugin logic.
+// Builds a new dictionary containing only the listed keys. With nested
+// paths, each picked value keeps its position, so "user.name" yields
+// {"user": {"name": ...}} and "items.*.id" keeps the id of every list
+// element. Lists keep only the picked elements, in order. Keys that do not
+// exist are skipped and reported in missing.
+// Inputs:
+//   - dict: the dictionary to pick from
+//   - keys: list of keys to keep
+//   - nested: (optional) treat keys as paths like dict.get, default true; when false keys are matched literally
+//
+// Returns:
+//   - result: the new dictionary
+//   - missing: keys that were not found
+//   - error: set when a key is empty or not a valid path
+func (p *DictPick) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"result": map[string]interface{}{}, "missing": []interface{}{}}
+	}
+	keys, _ := inputs["keys"].([]interface{})
+	nested := true
+	if v, ok := inputs["nested"].(bool); ok {
+		nested = v
+	}
+
+	if !nested {
+		result := map[string]interface{}{}
+		missing := []interface{}{}
+		for _, k := range keys {
+			key := fmt.Sprintf("%v", k)
+			if v, exists := dict[key]; exists {
+				result[key] = v
+			} else {
+				missing = append(missing, key)
+			}
+		}
+		return map[string]interface{}{"result": result, "missing": missing}
+	}
+
+	var picked []paths.Path
+	missing := []interface{}{}
+	for _, k := range keys {
+		key := fmt.Sprintf("%v", k)
+		path, err := paths.Parse(key)
+		if err != nil {
+			return map[string]interface{}{"result": map[string]interface{}{}, "missing": missing, "error": err.Error()}
+		}
+		if len(path) == 0 {
+			return map[string]interface{}{"result": map[string]interface{}{}, "missing": missing, "error": "key must not be empty"}
+		}
+		if len(paths.Expand(dict, path)) == 0 {
+			missing = append(missing, key)
+			continue
+		}
+		picked = append(picked, path)
+	}
+
+	result := paths.Pick(dict, picked...)
+	return map[string]interface{}{"result": result, "missing": missing}
+}
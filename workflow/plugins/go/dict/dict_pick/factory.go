@@ -0,0 +1,7 @@
+// Package dict_pick provides factory for DictPick plugin.
+package dict_pick
+
+// Create returns a new DictPick instance.
+func Create() *DictPick {
+	return NewDictPick()
+}
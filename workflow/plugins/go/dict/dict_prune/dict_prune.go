@@ -0,0 +1,134 @@
+// Package dict_prune provides a workflow plugin for removing empty dictionary values.
+package dict_prune
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictPrune implements the NodeExecutor interface for removing empty dictionary values.
+type DictPrune struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictPrune creates a new DictPrune instance.
+func NewDictPrune() *DictPrune {
+	return &DictPrune{
+		NodeType:    "dict.prune",
+		Category:    "dict",
+		Description: "Remove null and empty values from a dictionary",
+	}
+}
+
+// kinds are the values remove accepts.
+var kinds = map[string]bool{"null": true, "empty_string": true, "empty_list": true, "empty_dict": true}
+
+// Execute runs the plugin logic.
+// Deletes keys whose values are null or empty. Nested dictionaries are
+// pruned first, so a dictionary that only held removed values is itself
+// removed when empty_dict is selected.
+// Inputs:
+//   - dict: the dictionary to prune
+//   - remove: (optional) list of kinds to remove: null, empty_string, empty_list, empty_dict (default: all)
+//   - recursive: (optional) prune nested dictionaries, including those inside lists (default: true)
+//   - prune_lists: (optional) also drop matching elements from lists (default: false)
+//
+// Returns:
+//   - result: the pruned dictionary
+//   - removed: paths of the removed values, sorted
+//   - error: set when remove contains an unknown kind
+func (p *DictPrune) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"result": map[string]interface{}{}, "removed": []interface{}{}}
+	}
+
+	pr := &pruner{remove: kinds, recursive: true}
+	if list, ok := inputs["remove"].([]interface{}); ok {
+		pr.remove = map[string]bool{}
+		for _, k := range list {
+			kind := fmt.Sprintf("%v", k)
+			if !kinds[kind] {
+				return map[string]interface{}{"result": dict, "removed": []interface{}{}, "error": fmt.Sprintf("unknown kind %q", kind)}
+			}
+			pr.remove[kind] = true
+		}
+	}
+	if v, ok := inputs["recursive"].(bool); ok {
+		pr.recursive = v
+	}
+	pr.lists, _ = inputs["prune_lists"].(bool)
+
+	result := pr.pruneMap(nil, dict)
+	sort.Strings(pr.removed)
+	removed := make([]interface{}, len(pr.removed))
+	for i, path := range pr.removed {
+		removed[i] = path
+	}
+	return map[string]interface{}{"result": result, "removed": removed}
+}
+
+type pruner struct {
+	remove    map[string]bool
+	recursive bool
+	lists     bool
+	removed   []string
+}
+
+func (pr *pruner) pruneMap(path paths.Path, m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		child := append(path[:len(path):len(path)], paths.Segment{Key: k})
+		v = pr.descend(child, v)
+		if pr.empty(v) {
+			pr.removed = append(pr.removed, child.String())
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+func (pr *pruner) descend(path paths.Path, v interface{}) interface{} {
+	if !pr.recursive {
+		return v
+	}
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return pr.pruneMap(path, val)
+	case []interface{}:
+		result := make([]interface{}, 0, len(val))
+		for i, item := range val {
+			child := append(path[:len(path):len(path)], paths.Segment{Index: i, IsIndex: true})
+			item = pr.descend(child, item)
+			if pr.lists && pr.empty(item) {
+				pr.removed = append(pr.removed, child.String())
+				continue
+			}
+			result = append(result, item)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// empty reports whether v is one of the kinds being removed.
+func (pr *pruner) empty(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return pr.remove["null"]
+	case string:
+		return val == "" && pr.remove["empty_string"]
+	case []interface{}:
+		return len(val) == 0 && pr.remove["empty_list"]
+	case map[string]interface{}:
+		return len(val) == 0 && pr.remove["empty_dict"]
+	default:
+		return false
+	}
+}
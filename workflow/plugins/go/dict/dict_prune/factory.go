@@ -0,0 +1,7 @@
+// Package dict_prune provides factory for DictPrune plugin.
+package dict_prune
+
+// Create returns a new DictPrune instance.
+func Create() *DictPrune {
+	return NewDictPrune()
+}
@@ -0,0 +1,73 @@
+// Package dict_query provides a workflow plugin for querying dictionaries.
+package dict_query
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictQuery implements the NodeExecutor interface for querying dictionaries.
+type DictQuery struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictQuery creates a new DictQuery instance.
+func NewDictQuery() *DictQuery {
+	return &DictQuery{
+		NodeType:    "dict.query",
+		Category:    "dict",
+		Description: "Find every value matching one or more paths",
+	}
+}
+
+// Execute runs the plugin logic.
+// Returns every value addressed by the given paths, which may contain
+// wildcards (e.g., "users.*.email") and filters
+// (e.g., "users[?(@.age >= 18)].email"). Matches are listed path by path,
+// in key order for dictionaries and element order for lists.
+// Inputs:
+//   - dict: the dictionary to query
+//   - path: (optional) a single path to match
+//   - paths: (optional) list of paths to match, after path
+//
+// Returns:
+//   - result: list of matched values
+//   - matches: list of {path, value} with the concrete path of each match
+//   - count: number of matches
+//   - error: set when a path is invalid
+func (p *DictQuery) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	empty := map[string]interface{}{"result": []interface{}{}, "matches": []interface{}{}, "count": 0}
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return empty
+	}
+
+	var queries []string
+	if s, ok := inputs["path"].(string); ok {
+		queries = append(queries, s)
+	}
+	if list, ok := inputs["paths"].([]interface{}); ok {
+		for _, q := range list {
+			queries = append(queries, fmt.Sprintf("%v", q))
+		}
+	}
+
+	values := []interface{}{}
+	matches := []interface{}{}
+	for _, q := range queries {
+		path, err := paths.Parse(q)
+		if err != nil {
+			empty["error"] = err.Error()
+			return empty
+		}
+		for _, m := range paths.Expand(dict, path) {
+			values = append(values, m.Value)
+			matches = append(matches, map[string]interface{}{"path": m.Path.String(), "value": m.Value})
+		}
+	}
+
+	return map[string]interface{}{"result": values, "matches": matches, "count": len(values)}
+}
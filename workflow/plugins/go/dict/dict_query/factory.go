@@ -0,0 +1,7 @@
+// Package dict_query provides factory for DictQuery plugin.
+package dict_query
+
+// Create returns a new DictQuery instance.
+func Create() *DictQuery {
+	return NewDictQuery()
+}
@@ -2,7 +2,7 @@
 package dict_set
 
 import (
-	"strings"
+	"github.com/metabuilder/workflow-plugins-go/paths"
 )
 
 // DictSet implements the NodeExecutor interface for setting dictionary values.
@@ -23,8 +23,11 @@ func NewDictSet() *DictSet {
 
 // Execute runs the plugin logic.
 // Sets a value in a dictionary by key.
-// Supports dot notation for nested keys (e.g., "user.name").
-// Creates intermediate objects as needed.
+// Supports dot notation for nested keys (e.g., "user.name"), list indices
+// (e.g., "items[0].name"), backslash escapes for keys containing dots
+// (e.g., "a\.b") and wildcards that set every existing child
+// (e.g., "users.*.active"). See the paths package for the full syntax.
+// Creates intermediate objects as needed. The input dictionary is not modified.
 // Inputs:
 //   - dict: the dictionary to modify (or nil to create new)
 //   - key: the key to set (supports dot notation)
@@ -32,13 +35,11 @@ func NewDictSet() *DictSet {
 //
 // Returns:
 //   - result: the modified dictionary
+//   - error: set when the key is not a valid path or cannot be set
 func (p *DictSet) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	dict, ok := inputs["dict"].(map[string]interface{})
 	if !ok {
 		dict = make(map[string]interface{})
-	} else {
-		// Make a shallow copy to avoid mutating the original
-		dict = copyDict(dict)
 	}
 
 	key, ok := inputs["key"].(string)
@@ -46,52 +47,17 @@ func (p *DictSet) Execute(inputs map[string]interface{}, runtime interface{}) ma
 		return map[string]interface{}{"result": dict}
 	}
 
-	value := inputs["value"]
-
-	// Handle dot notation for nested keys
-	parts := strings.Split(key, ".")
-
-	if len(parts) == 1 {
-		// Simple key
-		dict[key] = value
-		return map[string]interface{}{"result": dict}
+	path, err := paths.Parse(key)
+	if err != nil {
+		return map[string]interface{}{"result": dict, "error": err.Error()}
 	}
-
-	// Nested key - navigate/create intermediate objects
-	current := dict
-	for i := 0; i < len(parts)-1; i++ {
-		part := parts[i]
-		if next, exists := current[part]; exists {
-			if nested, ok := next.(map[string]interface{}); ok {
-				// Copy nested dict to avoid mutation
-				copied := copyDict(nested)
-				current[part] = copied
-				current = copied
-			} else {
-				// Replace non-dict with new dict
-				newDict := make(map[string]interface{})
-				current[part] = newDict
-				current = newDict
-			}
-		} else {
-			// Create intermediate dict
-			newDict := make(map[string]interface{})
-			current[part] = newDict
-			current = newDict
-		}
+	if len(path) == 0 {
+		return map[string]interface{}{"result": dict, "error": "key must not be empty"}
 	}
 
-	// Set the final value
-	current[parts[len(parts)-1]] = value
-
-	return map[string]interface{}{"result": dict}
-}
-
-// copyDict creates a shallow copy of a dictionary.
-func copyDict(d map[string]interface{}) map[string]interface{} {
-	result := make(map[string]interface{}, len(d))
-	for k, v := range d {
-		result[k] = v
+	result, err := paths.Set(dict, path, inputs["value"])
+	if err != nil {
+		return map[string]interface{}{"result": dict, "error": err.Error()}
 	}
-	return result
+	return map[string]interface{}{"result": result}
 }
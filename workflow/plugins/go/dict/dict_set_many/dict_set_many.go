@@ -0,0 +1,86 @@
+// Package dict_set_many provides a workflow plugin for setting several dictionary values.
+package dict_set_many
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// DictSetMany implements the NodeExecutor interface for setting several dictionary values.
+type DictSetMany struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictSetMany creates a new DictSetMany instance.
+func NewDictSetMany() *DictSetMany {
+	return &DictSetMany{
+		NodeType:    "dict.set_many",
+		Category:    "dict",
+		Description: "Set several dictionary values in one step",
+	}
+}
+
+// Execute runs the plugin logic.
+// Applies several assignments, each like dict.set, while copying the
+// dictionary only once. Assignments given as a dictionary are applied in
+// sorted path order, so "user" is set before "user.name"; use a list of
+// {path, value} objects to choose the order. The input dictionary is not
+// modified, and nothing is applied if any assignment fails.
+// Inputs:
+//   - dict: the dictionary to modify (or nil to create new)
+//   - values: dictionary of path to value, or list of {path, value}
+//
+// Returns:
+//   - result: the modified dictionary
+//   - error: set when a path is invalid or cannot be set
+func (p *DictSetMany) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		dict = make(map[string]interface{})
+	}
+
+	var keys []string
+	var values []interface{}
+	switch v := inputs["values"].(type) {
+	case map[string]interface{}:
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			values = append(values, v[k])
+		}
+	case []interface{}:
+		for i, item := range v {
+			entry, ok := item.(map[string]interface{})
+			key, isString := entry["path"].(string)
+			if !ok || !isString {
+				return map[string]interface{}{"result": dict, "error": fmt.Sprintf("values[%d] must be an object with a string path", i)}
+			}
+			keys = append(keys, key)
+			values = append(values, entry["value"])
+		}
+	}
+
+	ps := make([]paths.Path, len(keys))
+	for i, k := range keys {
+		path, err := paths.Parse(k)
+		if err != nil {
+			return map[string]interface{}{"result": dict, "error": err.Error()}
+		}
+		if len(path) == 0 {
+			return map[string]interface{}{"result": dict, "error": "path must not be empty"}
+		}
+		ps[i] = path
+	}
+
+	result, err := paths.SetMany(dict, ps, values)
+	if err != nil {
+		return map[string]interface{}{"result": dict, "error": err.Error()}
+	}
+	return map[string]interface{}{"result": result}
+}
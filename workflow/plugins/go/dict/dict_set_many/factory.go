@@ -0,0 +1,7 @@
+// Package dict_set_many provides factory for DictSetMany plugin.
+package dict_set_many
+
+// Create returns a new DictSetMany instance.
+func Create() *DictSetMany {
+	return NewDictSetMany()
+}
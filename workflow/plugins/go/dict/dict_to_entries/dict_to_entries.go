@@ -0,0 +1,61 @@
+// Package dict_to_entries provides a workflow plugin for converting dictionaries to entry lists.
+package dict_to_entries
+
+import (
+	"sort"
+)
+
+// DictToEntries implements the NodeExecutor interface for converting dictionaries to entry lists.
+type DictToEntries struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictToEntries creates a new DictToEntries instance.
+func NewDictToEntries() *DictToEntries {
+	return &DictToEntries{
+		NodeType:    "dict.to_entries",
+		Category:    "dict",
+		Description: "Convert a dictionary to a list of key/value entries",
+	}
+}
+
+// Execute runs the plugin logic.
+// Converts a dictionary into a list of {key, value} objects sorted by key,
+// so entries can be transformed with list plugins and rebuilt with
+// dict.from_entries.
+// Inputs:
+//   - dict: the dictionary to convert
+//   - key_field: (optional) name of the key field in each entry (default: "key")
+//   - value_field: (optional) name of the value field in each entry (default: "value")
+//
+// Returns:
+//   - result: list of entries
+func (p *DictToEntries) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"result": []interface{}{}}
+	}
+	keyField, _ := inputs["key_field"].(string)
+	if keyField == "" {
+		keyField = "key"
+	}
+	valueField, _ := inputs["value_field"].(string)
+	if valueField == "" {
+		valueField = "value"
+	}
+
+	keys := make([]string, 0, len(dict))
+	for k := range dict {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	entries := make([]interface{}, len(keys))
+	for i, k := range keys {
+		entries[i] = map[string]interface{}{keyField: k, valueField: dict[k]}
+	}
+
+	return map[string]interface{}{"result": entries}
+}
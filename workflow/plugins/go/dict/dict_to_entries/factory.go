@@ -0,0 +1,7 @@
+// Package dict_to_entries provides factory for DictToEntries plugin.
+package dict_to_entries
+
+// Create returns a new DictToEntries instance.
+func Create() *DictToEntries {
+	return NewDictToEntries()
+}
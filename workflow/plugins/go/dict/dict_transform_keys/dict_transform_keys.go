@@ -0,0 +1,110 @@
+// Package dict_transform_keys provides a workflow plugin for renaming dictionary keys.
+package dict_transform_keys
+
+import (
+	"sort"
+
+	"github.com/metabuilder/workflow-plugins-go/casing"
+)
+
+// DictTransformKeys implements the NodeExecutor interface for renaming dictionary keys.
+type DictTransformKeys struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewDictTransformKeys creates a new DictTransformKeys instance.
+func NewDictTransformKeys() *DictTransformKeys {
+	return &DictTransformKeys{
+		NodeType:    "dict.transform_keys",
+		Category:    "dict",
+		Description: "Convert dictionary keys between naming styles",
+	}
+}
+
+// Execute runs the plugin logic.
+// Rewrites every key in the given naming style, e.g. "user_id" to "userId"
+// for camel. Words are split on separators and case changes, so any input
+// style works. When two keys convert to the same name, the one that comes
+// last in sorted order wins and the name is reported in collisions.
+// Inputs:
+//   - dict: the dictionary to transform
+//   - to: target style: snake, camel, kebab, pascal, constant or title
+//   - recursive: (optional) also transform nested dictionaries, including those inside lists (default: true)
+//
+// Returns:
+//   - result: the dictionary with renamed keys
+//   - collisions: converted keys that more than one original key mapped to
+//   - error: set when the style is unknown
+func (p *DictTransformKeys) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	dict, ok := inputs["dict"].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"result": map[string]interface{}{}, "collisions": []interface{}{}}
+	}
+	style, _ := inputs["to"].(string)
+	if _, err := casing.Convert("", style); err != nil {
+		return map[string]interface{}{"result": dict, "collisions": []interface{}{}, "error": err.Error()}
+	}
+	recursive := true
+	if v, ok := inputs["recursive"].(bool); ok {
+		recursive = v
+	}
+
+	t := &transformer{style: style, recursive: recursive, seen: map[string]bool{}}
+	result := t.transformMap(dict)
+
+	collisions := make([]interface{}, 0, len(t.collisions))
+	for _, k := range t.collisions {
+		collisions = append(collisions, k)
+	}
+	return map[string]interface{}{"result": result, "collisions": collisions}
+}
+
+type transformer struct {
+	style      string
+	recursive  bool
+	collisions []string
+	seen       map[string]bool
+}
+
+func (t *transformer) transformMap(m map[string]interface{}) map[string]interface{} {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make(map[string]interface{}, len(m))
+	for _, k := range keys {
+		name, _ := casing.Convert(k, t.style)
+		if name == "" {
+			// Keys without letters or digits have no words to convert
+			name = k
+		}
+		if _, exists := result[name]; exists && !t.seen[name] {
+			t.seen[name] = true
+			t.collisions = append(t.collisions, name)
+		}
+		result[name] = t.transformValue(m[k])
+	}
+	return result
+}
+
+func (t *transformer) transformValue(v interface{}) interface{} {
+	if !t.recursive {
+		return v
+	}
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return t.transformMap(val)
+	case []interface{}:
+		result := make([]interface{}, len(val))
+		for i, item := range val {
+			result[i] = t.transformValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
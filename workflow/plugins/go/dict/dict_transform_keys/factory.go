@@ -0,0 +1,7 @@
+// Package dict_transform_keys provides factory for DictTransformKeys plugin.
+package dict_transform_keys
+
+// Create returns a new DictTransformKeys instance.
+func Create() *DictTransformKeys {
+	return NewDictTransformKeys()
+}
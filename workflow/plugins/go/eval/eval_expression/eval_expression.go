@@ -0,0 +1,66 @@
+// Package eval_expression provides a workflow plugin for evaluating expressions.
+package eval_expression
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/expr"
+)
+
+// EvalExpression implements the NodeExecutor interface for evaluating expressions.
+type EvalExpression struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewEvalExpression creates a new EvalExpression instance.
+func NewEvalExpression() *EvalExpression {
+	return &EvalExpression{
+		NodeType:    "eval.expression",
+		Category:    "eval",
+		Description: "Evaluate an expression against inputs and the workflow store",
+	}
+}
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// Execute runs the plugin logic.
+// Evaluates an expression written in Go expression syntax, e.g.
+// "order.total * (1 - discount) > 100 && user.active".
+// Identifiers resolve against vars first, then the workflow store.
+// See the expr package for the supported operators and functions.
+// Inputs:
+//   - expression: the expression to evaluate
+//   - vars: (optional) dictionary of variables available to the expression
+//
+// Returns:
+//   - result: the value of the expression
+//   - error: present if the expression could not be parsed or evaluated
+func (p *EvalExpression) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	expression, _ := inputs["expression"].(string)
+	vars, _ := inputs["vars"].(map[string]interface{})
+
+	// Try to access the runtime store
+	var store map[string]interface{}
+	if r, ok := runtime.(Runtime); ok {
+		store = r.GetStore()
+	} else if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			store = s
+		}
+	}
+
+	x, err := expr.Parse(expression)
+	if err != nil {
+		return map[string]interface{}{"result": nil, "error": err.Error()}
+	}
+
+	result, err := x.Eval(vars, store)
+	if err != nil {
+		return map[string]interface{}{"result": nil, "error": err.Error()}
+	}
+
+	return map[string]interface{}{"result": result}
+}
@@ -0,0 +1,7 @@
+// Package eval_expression provides factory for EvalExpression plugin.
+package eval_expression
+
+// Create returns a new EvalExpression instance.
+func Create() *EvalExpression {
+	return NewEvalExpression()
+}
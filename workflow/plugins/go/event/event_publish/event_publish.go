@@ -0,0 +1,57 @@
+// Package event_publish provides a workflow plugin for publishing in-run events.
+package event_publish
+
+// EventPublish implements the NodeExecutor interface for publishing in-run events.
+type EventPublish struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewEventPublish creates a new EventPublish instance.
+func NewEventPublish() *EventPublish {
+	return &EventPublish{
+		NodeType:    "event.publish",
+		Category:    "event",
+		Description: "Publish an event on the in-run event bus",
+	}
+}
+
+// Runtime interface for accessing the in-run event bus.
+type Runtime interface {
+	Publish(topic string, payload interface{}) int
+}
+
+// Execute runs the plugin logic.
+// Publishes a payload on a topic so branches waiting in event.subscribe resume.
+// Inputs:
+//   - topic: the topic to publish on
+//   - payload: (optional) the value delivered to subscribers
+//
+// Returns:
+//   - success: whether the event was published
+//   - delivered: number of subscribers that were waiting for the event
+func (p *EventPublish) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	topic, ok := inputs["topic"].(string)
+	if !ok || topic == "" {
+		return map[string]interface{}{"success": false, "error": "topic is required"}
+	}
+
+	// Try to access the runtime event bus
+	var bus Runtime
+	if r, ok := runtime.(Runtime); ok {
+		bus = r
+	} else if r, ok := runtime.(map[string]interface{}); ok {
+		if b, ok := r["Events"].(Runtime); ok {
+			bus = b
+		}
+	}
+
+	if bus == nil {
+		return map[string]interface{}{"success": false, "error": "runtime event bus not available"}
+	}
+
+	delivered := bus.Publish(topic, inputs["payload"])
+
+	return map[string]interface{}{"success": true, "delivered": delivered}
+}
@@ -0,0 +1,7 @@
+// Package event_publish provides factory for EventPublish plugin.
+package event_publish
+
+// Create returns a new EventPublish instance.
+func Create() *EventPublish {
+	return NewEventPublish()
+}
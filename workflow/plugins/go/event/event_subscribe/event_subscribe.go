@@ -0,0 +1,81 @@
+// Package event_subscribe provides a workflow plugin for waiting on in-run events.
+package event_subscribe
+
+import (
+	"time"
+)
+
+// defaultTimeoutMs is used when no timeout is supplied.
+const defaultTimeoutMs = 30000
+
+// EventSubscribe implements the NodeExecutor interface for waiting on in-run events.
+type EventSubscribe struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewEventSubscribe creates a new EventSubscribe instance.
+func NewEventSubscribe() *EventSubscribe {
+	return &EventSubscribe{
+		NodeType:    "event.subscribe",
+		Category:    "event",
+		Description: "Wait for an event on the in-run event bus",
+	}
+}
+
+// Runtime interface for accessing the in-run event bus.
+type Runtime interface {
+	WaitEvent(topic string, timeout time.Duration, replay bool) (interface{}, bool)
+}
+
+// Execute runs the plugin logic.
+// Blocks until an event is published on the topic or the timeout elapses.
+// Inputs:
+//   - topic: the topic to wait on
+//   - timeout_ms: (optional) how long to wait in milliseconds (default: 30000, 0 = don't wait)
+//   - replay: (optional) return immediately if the topic was already published (default: true)
+//
+// Returns:
+//   - received: whether an event arrived before the timeout
+//   - payload: the event payload, or nil
+func (p *EventSubscribe) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	topic, ok := inputs["topic"].(string)
+	if !ok || topic == "" {
+		return map[string]interface{}{"received": false, "payload": nil, "error": "topic is required"}
+	}
+
+	timeoutMs := float64(defaultTimeoutMs)
+	switch t := inputs["timeout_ms"].(type) {
+	case float64:
+		timeoutMs = t
+	case int:
+		timeoutMs = float64(t)
+	case int64:
+		timeoutMs = float64(t)
+	}
+
+	replay := true
+	if r, ok := inputs["replay"].(bool); ok {
+		replay = r
+	}
+
+	// Try to access the runtime event bus
+	var bus Runtime
+	if r, ok := runtime.(Runtime); ok {
+		bus = r
+	} else if r, ok := runtime.(map[string]interface{}); ok {
+		if b, ok := r["Events"].(Runtime); ok {
+			bus = b
+		}
+	}
+
+	if bus == nil {
+		return map[string]interface{}{"received": false, "payload": nil, "error": "runtime event bus not available"}
+	}
+
+	timeout := time.Duration(timeoutMs * float64(time.Millisecond))
+	payload, received := bus.WaitEvent(topic, timeout, replay)
+
+	return map[string]interface{}{"received": received, "payload": payload}
+}
@@ -0,0 +1,7 @@
+// Package event_subscribe provides factory for EventSubscribe plugin.
+package event_subscribe
+
+// Create returns a new EventSubscribe instance.
+func Create() *EventSubscribe {
+	return NewEventSubscribe()
+}
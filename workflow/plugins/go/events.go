@@ -0,0 +1,93 @@
+package plugin
+
+import (
+	"sync"
+	"time"
+)
+
+// EventBus is an in-memory publish/subscribe bus scoped to a single workflow run.
+// The last payload of every topic is retained so subscribers that start after
+// a publish can still observe it.
+type EventBus struct {
+	mu      sync.Mutex
+	last    map[string]interface{}
+	waiters map[string][]chan interface{}
+}
+
+// NewEventBus creates an empty event bus.
+func NewEventBus() *EventBus {
+	return &EventBus{
+		last:    make(map[string]interface{}),
+		waiters: make(map[string][]chan interface{}),
+	}
+}
+
+// Publish records payload as the latest event for topic and wakes all
+// subscribers currently waiting on it. Returns the number of woken subscribers.
+func (b *EventBus) Publish(topic string, payload interface{}) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.last[topic] = payload
+	waiters := b.waiters[topic]
+	delete(b.waiters, topic)
+
+	for _, ch := range waiters {
+		// Buffered with capacity 1, never blocks
+		ch <- payload
+	}
+	return len(waiters)
+}
+
+// WaitEvent blocks until an event is published on topic or timeout elapses.
+// If replay is true and topic has already been published, the latest payload
+// is returned immediately. A non-positive timeout does not block.
+func (b *EventBus) WaitEvent(topic string, timeout time.Duration, replay bool) (interface{}, bool) {
+	b.mu.Lock()
+	if replay {
+		if payload, ok := b.last[topic]; ok {
+			b.mu.Unlock()
+			return payload, true
+		}
+	}
+	if timeout <= 0 {
+		b.mu.Unlock()
+		return nil, false
+	}
+	ch := make(chan interface{}, 1)
+	b.waiters[topic] = append(b.waiters[topic], ch)
+	b.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case payload := <-ch:
+		return payload, true
+	case <-timer.C:
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		// A publish may have raced the timer; prefer the delivered event
+		select {
+		case payload := <-ch:
+			return payload, true
+		default:
+		}
+		b.removeWaiter(topic, ch)
+		return nil, false
+	}
+}
+
+// removeWaiter drops ch from the waiters of topic. Callers must hold b.mu.
+func (b *EventBus) removeWaiter(topic string, ch chan interface{}) {
+	waiters := b.waiters[topic]
+	for i, w := range waiters {
+		if w == ch {
+			b.waiters[topic] = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(b.waiters[topic]) == 0 {
+		delete(b.waiters, topic)
+	}
+}
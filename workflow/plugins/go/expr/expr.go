@@ -0,0 +1,518 @@
+// Package expr evaluates the Go-syntax expressions used by eval.expression
+// and by list plugins that accept an expression, e.g.
+// "order.total * (1 - discount) > 100 && user.active".
+//
+// Identifiers resolve against vars first, then the workflow store.
+// Numbers are evaluated as float64 to match JSON semantics.
+// Supported functions: len, abs, min, max, round, floor, ceil, sqrt,
+// upper, lower, trim, contains, startsWith, endsWith, str, num, ifelse.
+package expr
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Expr is a parsed expression. It can be evaluated any number of times.
+type Expr struct {
+	node ast.Expr
+}
+
+// Parse parses an expression.
+func Parse(s string) (*Expr, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, fmt.Errorf("expression is required")
+	}
+	node, err := parser.ParseExpr(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression: %v", err)
+	}
+	return &Expr{node: node}, nil
+}
+
+// Eval evaluates the expression. Either map may be nil.
+func (x *Expr) Eval(vars, store map[string]interface{}) (interface{}, error) {
+	e := &evaluator{vars: vars, store: store}
+	return e.eval(x.node)
+}
+
+// Truthy reports whether v counts as true in a condition: false, zero,
+// the empty string and null are false, everything else is true.
+func Truthy(v interface{}) bool {
+	return toBool(v)
+}
+
+// evaluator walks a parsed expression tree and computes its value.
+type evaluator struct {
+	vars  map[string]interface{}
+	store map[string]interface{}
+}
+
+// eval computes the value of a single expression node.
+func (e *evaluator) eval(node ast.Expr) (interface{}, error) {
+	switch n := node.(type) {
+	case *ast.BasicLit:
+		return evalLiteral(n)
+	case *ast.Ident:
+		return e.lookup(n.Name)
+	case *ast.ParenExpr:
+		return e.eval(n.X)
+	case *ast.UnaryExpr:
+		return e.evalUnary(n)
+	case *ast.BinaryExpr:
+		return e.evalBinary(n)
+	case *ast.SelectorExpr:
+		x, err := e.eval(n.X)
+		if err != nil {
+			return nil, err
+		}
+		obj, ok := x.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot access field %q on %s", n.Sel.Name, typeName(x))
+		}
+		return obj[n.Sel.Name], nil
+	case *ast.IndexExpr:
+		return e.evalIndex(n)
+	case *ast.CallExpr:
+		return e.evalCall(n)
+	default:
+		return nil, fmt.Errorf("unsupported expression at offset %d", node.Pos()-1)
+	}
+}
+
+// lookup resolves an identifier against constants, vars, and the store.
+func (e *evaluator) lookup(name string) (interface{}, error) {
+	switch name {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "nil", "null":
+		return nil, nil
+	}
+	if val, exists := e.vars[name]; exists {
+		return val, nil
+	}
+	if val, exists := e.store[name]; exists {
+		return val, nil
+	}
+	return nil, fmt.Errorf("undefined: %s", name)
+}
+
+// evalLiteral converts a literal token into a value.
+func evalLiteral(lit *ast.BasicLit) (interface{}, error) {
+	switch lit.Kind {
+	case token.INT, token.FLOAT:
+		f, err := strconv.ParseFloat(lit.Value, 64)
+		if err != nil {
+			// Handles hex/octal/binary integer literals
+			i, ierr := strconv.ParseInt(lit.Value, 0, 64)
+			if ierr != nil {
+				return nil, fmt.Errorf("invalid number %s", lit.Value)
+			}
+			return float64(i), nil
+		}
+		return f, nil
+	case token.STRING, token.CHAR:
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string %s", lit.Value)
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unsupported literal %s", lit.Value)
+	}
+}
+
+// evalUnary handles !, - and + prefixes.
+func (e *evaluator) evalUnary(n *ast.UnaryExpr) (interface{}, error) {
+	x, err := e.eval(n.X)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case token.NOT:
+		return !toBool(x), nil
+	case token.SUB, token.ADD:
+		num, ok := toFloat64(x)
+		if !ok {
+			return nil, fmt.Errorf("operator %s not defined on %s", n.Op, typeName(x))
+		}
+		if n.Op == token.SUB {
+			return -num, nil
+		}
+		return num, nil
+	default:
+		return nil, fmt.Errorf("unsupported operator %s", n.Op)
+	}
+}
+
+// evalBinary handles arithmetic, comparison and logical operators.
+func (e *evaluator) evalBinary(n *ast.BinaryExpr) (interface{}, error) {
+	left, err := e.eval(n.X)
+	if err != nil {
+		return nil, err
+	}
+
+	// Short-circuit logical operators
+	switch n.Op {
+	case token.LAND:
+		if !toBool(left) {
+			return false, nil
+		}
+		right, err := e.eval(n.Y)
+		if err != nil {
+			return nil, err
+		}
+		return toBool(right), nil
+	case token.LOR:
+		if toBool(left) {
+			return true, nil
+		}
+		right, err := e.eval(n.Y)
+		if err != nil {
+			return nil, err
+		}
+		return toBool(right), nil
+	}
+
+	right, err := e.eval(n.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case token.EQL:
+		return valuesEqual(left, right), nil
+	case token.NEQ:
+		return !valuesEqual(left, right), nil
+	}
+
+	// String concatenation and comparison
+	ls, lIsStr := left.(string)
+	rs, rIsStr := right.(string)
+	if lIsStr && rIsStr {
+		switch n.Op {
+		case token.ADD:
+			return ls + rs, nil
+		case token.LSS:
+			return ls < rs, nil
+		case token.LEQ:
+			return ls <= rs, nil
+		case token.GTR:
+			return ls > rs, nil
+		case token.GEQ:
+			return ls >= rs, nil
+		}
+		return nil, fmt.Errorf("operator %s not defined on strings", n.Op)
+	}
+
+	ln, lok := toFloat64(left)
+	rn, rok := toFloat64(right)
+	if !lok || !rok {
+		return nil, fmt.Errorf("operator %s not defined on %s and %s", n.Op, typeName(left), typeName(right))
+	}
+
+	switch n.Op {
+	case token.ADD:
+		return ln + rn, nil
+	case token.SUB:
+		return ln - rn, nil
+	case token.MUL:
+		return ln * rn, nil
+	case token.QUO:
+		if rn == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return ln / rn, nil
+	case token.REM:
+		if rn == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return math.Mod(ln, rn), nil
+	case token.LSS:
+		return ln < rn, nil
+	case token.LEQ:
+		return ln <= rn, nil
+	case token.GTR:
+		return ln > rn, nil
+	case token.GEQ:
+		return ln >= rn, nil
+	default:
+		return nil, fmt.Errorf("unsupported operator %s", n.Op)
+	}
+}
+
+// evalIndex handles dict["key"] and list[index] lookups.
+func (e *evaluator) evalIndex(n *ast.IndexExpr) (interface{}, error) {
+	x, err := e.eval(n.X)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := e.eval(n.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := x.(type) {
+	case map[string]interface{}:
+		key, ok := idx.(string)
+		if !ok {
+			return nil, fmt.Errorf("dictionary index must be a string, got %s", typeName(idx))
+		}
+		return v[key], nil
+	case []interface{}:
+		f, ok := toFloat64(idx)
+		if !ok || f != math.Trunc(f) {
+			return nil, fmt.Errorf("list index must be an integer, got %v", idx)
+		}
+		i := int(f)
+		if i < 0 {
+			i = len(v) + i
+		}
+		if i < 0 || i >= len(v) {
+			return nil, nil
+		}
+		return v[i], nil
+	default:
+		return nil, fmt.Errorf("cannot index %s", typeName(x))
+	}
+}
+
+// evalCall dispatches calls to the built-in function set.
+func (e *evaluator) evalCall(n *ast.CallExpr) (interface{}, error) {
+	ident, ok := n.Fun.(*ast.Ident)
+	if !ok {
+		return nil, fmt.Errorf("unsupported function call")
+	}
+
+	args := make([]interface{}, len(n.Args))
+	for i, a := range n.Args {
+		v, err := e.eval(a)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+
+	switch ident.Name {
+	case "len":
+		if err := wantArgs(ident.Name, args, 1); err != nil {
+			return nil, err
+		}
+		switch v := args[0].(type) {
+		case string:
+			return float64(len([]rune(v))), nil
+		case []interface{}:
+			return float64(len(v)), nil
+		case map[string]interface{}:
+			return float64(len(v)), nil
+		}
+		return nil, fmt.Errorf("len not defined on %s", typeName(args[0]))
+	case "abs", "round", "floor", "ceil", "sqrt":
+		if err := wantArgs(ident.Name, args, 1); err != nil {
+			return nil, err
+		}
+		f, ok := toFloat64(args[0])
+		if !ok {
+			return nil, fmt.Errorf("%s requires a number, got %s", ident.Name, typeName(args[0]))
+		}
+		switch ident.Name {
+		case "abs":
+			return math.Abs(f), nil
+		case "round":
+			return math.Round(f), nil
+		case "floor":
+			return math.Floor(f), nil
+		case "ceil":
+			return math.Ceil(f), nil
+		default:
+			if f < 0 {
+				return nil, fmt.Errorf("sqrt of negative number")
+			}
+			return math.Sqrt(f), nil
+		}
+	case "min", "max":
+		if len(args) == 0 {
+			return nil, fmt.Errorf("%s requires at least one argument", ident.Name)
+		}
+		var best float64
+		for i, a := range args {
+			f, ok := toFloat64(a)
+			if !ok {
+				return nil, fmt.Errorf("%s requires numbers, got %s", ident.Name, typeName(a))
+			}
+			if i == 0 || (ident.Name == "min" && f < best) || (ident.Name == "max" && f > best) {
+				best = f
+			}
+		}
+		return best, nil
+	case "upper", "lower", "trim":
+		if err := wantArgs(ident.Name, args, 1); err != nil {
+			return nil, err
+		}
+		s, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("%s requires a string, got %s", ident.Name, typeName(args[0]))
+		}
+		switch ident.Name {
+		case "upper":
+			return strings.ToUpper(s), nil
+		case "lower":
+			return strings.ToLower(s), nil
+		default:
+			return strings.TrimSpace(s), nil
+		}
+	case "contains":
+		if err := wantArgs(ident.Name, args, 2); err != nil {
+			return nil, err
+		}
+		switch v := args[0].(type) {
+		case string:
+			sub, ok := args[1].(string)
+			return ok && strings.Contains(v, sub), nil
+		case []interface{}:
+			for _, item := range v {
+				if valuesEqual(item, args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case map[string]interface{}:
+			key, ok := args[1].(string)
+			if !ok {
+				return false, nil
+			}
+			_, exists := v[key]
+			return exists, nil
+		}
+		return nil, fmt.Errorf("contains not defined on %s", typeName(args[0]))
+	case "startsWith", "endsWith":
+		if err := wantArgs(ident.Name, args, 2); err != nil {
+			return nil, err
+		}
+		s, ok1 := args[0].(string)
+		affix, ok2 := args[1].(string)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("%s requires strings", ident.Name)
+		}
+		if ident.Name == "startsWith" {
+			return strings.HasPrefix(s, affix), nil
+		}
+		return strings.HasSuffix(s, affix), nil
+	case "str":
+		if err := wantArgs(ident.Name, args, 1); err != nil {
+			return nil, err
+		}
+		if args[0] == nil {
+			return "", nil
+		}
+		if f, ok := toFloat64(args[0]); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+		return fmt.Sprintf("%v", args[0]), nil
+	case "num":
+		if err := wantArgs(ident.Name, args, 1); err != nil {
+			return nil, err
+		}
+		if f, ok := toFloat64(args[0]); ok {
+			return f, nil
+		}
+		if s, ok := args[0].(string); ok {
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number string %q", s)
+			}
+			return f, nil
+		}
+		return nil, fmt.Errorf("cannot convert %s to number", typeName(args[0]))
+	case "ifelse":
+		if err := wantArgs(ident.Name, args, 3); err != nil {
+			return nil, err
+		}
+		if toBool(args[0]) {
+			return args[1], nil
+		}
+		return args[2], nil
+	default:
+		return nil, fmt.Errorf("unknown function: %s", ident.Name)
+	}
+}
+
+// wantArgs checks the argument count for a built-in function.
+func wantArgs(name string, args []interface{}, n int) error {
+	if len(args) != n {
+		return fmt.Errorf("%s expects %d argument(s), got %d", name, n, len(args))
+	}
+	return nil
+}
+
+// valuesEqual compares two values, treating all numeric types as equal by value.
+func valuesEqual(a, b interface{}) bool {
+	aNum, aIsNum := toFloat64(a)
+	bNum, bIsNum := toFloat64(b)
+	if aIsNum && bIsNum {
+		return aNum == bNum
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// typeName returns a short, user-facing name for a value's type.
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case string:
+		return "string"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "dict"
+	}
+	if _, ok := toFloat64(v); ok {
+		return "number"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func toBool(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case int:
+		return b != 0
+	case float64:
+		return b != 0
+	case string:
+		return b != ""
+	default:
+		return v != nil
+	}
+}
+
+// toFloat64 converts various numeric types to float64.
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,137 @@
+// Package grapheme splits text into user-perceived characters (grapheme
+// clusters), so string plugins can count, cut and reverse text without
+// separating an accent from its letter or breaking up an emoji.
+//
+// The segmentation follows the main rules of Unicode UAX #29 using only the
+// standard library's tables: CR LF, combining marks and other extending
+// characters, variation selectors and emoji modifiers, zero-width joiner
+// sequences, regional indicator pairs (flags) and Hangul syllables. Rarer
+// rules, such as prepended concatenation marks, are not applied.
+package grapheme
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Split returns the grapheme clusters of s in order.
+func Split(s string) []string {
+	var clusters []string
+	for len(s) > 0 {
+		n := next(s)
+		clusters = append(clusters, s[:n])
+		s = s[n:]
+	}
+	return clusters
+}
+
+// Count returns the number of grapheme clusters in s.
+func Count(s string) int {
+	count := 0
+	for len(s) > 0 {
+		s = s[next(s):]
+		count++
+	}
+	return count
+}
+
+// next returns the byte length of the first cluster of s.
+func next(s string) int {
+	prev, size := utf8.DecodeRuneInString(s)
+	i := size
+	regional := 0
+	if isRegional(prev) {
+		regional = 1
+	}
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !joins(prev, r, regional) {
+			break
+		}
+		if isRegional(r) {
+			regional++
+		}
+		prev = r
+		i += size
+	}
+	return i
+}
+
+// joins reports whether r continues the cluster ending in prev. regional
+// is the number of regional indicators in the cluster so far.
+func joins(prev, r rune, regional int) bool {
+	switch {
+	case prev == '\r':
+		return r == '\n'
+	case isControl(prev) || isControl(r):
+		return false
+	case isExtend(r) || r == zwj:
+		return true
+	case prev == zwj:
+		return true
+	case isRegional(prev) && isRegional(r):
+		return regional%2 == 1
+	}
+	return hangul(prev, r)
+}
+
+const zwj = '\u200d'
+
+func isControl(r rune) bool {
+	return r != zwj && (unicode.IsControl(r) || unicode.Is(unicode.Zl, r) || unicode.Is(unicode.Zp, r))
+}
+
+// isExtend reports whether r attaches to the preceding character:
+// combining marks, variation selectors, emoji modifiers and tags.
+func isExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r >= 0xFE00 && r <= 0xFE0F ||
+		r >= 0xE0100 && r <= 0xE01EF ||
+		r >= 0x1F3FB && r <= 0x1F3FF ||
+		r >= 0xE0020 && r <= 0xE007F
+}
+
+func isRegional(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// Hangul syllable types.
+const (
+	hangulNone = iota
+	hangulL
+	hangulV
+	hangulT
+	hangulLV
+	hangulLVT
+)
+
+func hangulType(r rune) int {
+	switch {
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return hangulL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return hangulV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return hangulT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+	return hangulNone
+}
+
+// hangul reports whether two Hangul jamo or syllables form one syllable.
+func hangul(prev, r rune) bool {
+	a, b := hangulType(prev), hangulType(r)
+	switch a {
+	case hangulL:
+		return b == hangulL || b == hangulV || b == hangulLV || b == hangulLVT
+	case hangulLV, hangulV:
+		return b == hangulV || b == hangulT
+	case hangulLVT, hangulT:
+		return b == hangulT
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// Package hashing derives identity keys for workflow values, used where
+// plugins compare elements as a set (list.unique, list.union and friends).
+//
+// Two values get the same key exactly when they are equal as JSON: numbers
+// compare by value whatever their Go type (1, int64(1) and 1.0 are equal),
+// map key order does not matter, and a string never equals a number.
+package hashing
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// Key returns the canonical JSON encoding of v, usable as a map key.
+func Key(v interface{}) string {
+	var b strings.Builder
+	write(&b, v)
+	return b.String()
+}
+
+// Sum64 returns the 64-bit FNV-1a hash of Key(v), for callers that need a
+// fixed-size identity and can tolerate the rare collision.
+func Sum64(v interface{}) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(Key(v)))
+	return h.Sum64()
+}
+
+// Identity returns the key an element is compared by in the list set
+// operations. Objects having at least one of keys are identified by the
+// values at those paths (missing ones as null); anything else, or any
+// element when no keys are given, by the element itself. The two cases are
+// tagged apart, so {"id": {"x": 1}} compared by id never matches the
+// element {"x": 1}.
+func Identity(item interface{}, keys ...paths.Path) string {
+	if _, ok := item.(map[string]interface{}); ok && len(keys) > 0 {
+		values := make([]interface{}, 0, len(keys)+1)
+		values = append(values, "k")
+		found := false
+		for _, key := range keys {
+			val, exists := paths.Get(item, key)
+			values = append(values, val)
+			found = found || exists
+		}
+		if found {
+			return Key(values)
+		}
+	}
+	return Key([]interface{}{"v", item})
+}
+
+func write(b *strings.Builder, v interface{}) {
+	switch val := v.(type) {
+	case nil:
+		b.WriteString("null")
+	case bool:
+		b.WriteString(strconv.FormatBool(val))
+	case string:
+		writeString(b, val)
+	case int:
+		b.WriteString(strconv.FormatInt(int64(val), 10))
+	case int8:
+		b.WriteString(strconv.FormatInt(int64(val), 10))
+	case int16:
+		b.WriteString(strconv.FormatInt(int64(val), 10))
+	case int32:
+		b.WriteString(strconv.FormatInt(int64(val), 10))
+	case int64:
+		b.WriteString(strconv.FormatInt(val, 10))
+	case uint:
+		b.WriteString(strconv.FormatUint(uint64(val), 10))
+	case uint8:
+		b.WriteString(strconv.FormatUint(uint64(val), 10))
+	case uint16:
+		b.WriteString(strconv.FormatUint(uint64(val), 10))
+	case uint32:
+		b.WriteString(strconv.FormatUint(uint64(val), 10))
+	case uint64:
+		b.WriteString(strconv.FormatUint(val, 10))
+	case float32:
+		writeFloat(b, float64(val))
+	case float64:
+		writeFloat(b, val)
+	case json.Number:
+		if f, err := val.Float64(); err == nil {
+			writeFloat(b, f)
+		} else {
+			writeString(b, val.String())
+		}
+	case []interface{}:
+		b.WriteByte('[')
+		for i, item := range val {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			write(b, item)
+		}
+		b.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeString(b, k)
+			b.WriteByte(':')
+			write(b, val[k])
+		}
+		b.WriteByte('}')
+	default:
+		// Other types (typed slices, structs) go through encoding/json and
+		// back so they match their generic equivalents
+		data, err := json.Marshal(val)
+		if err != nil {
+			fmt.Fprintf(b, "%T:%v", val, val)
+			return
+		}
+		var generic interface{}
+		if err := json.Unmarshal(data, &generic); err != nil {
+			b.Write(data)
+			return
+		}
+		write(b, generic)
+	}
+}
+
+// writeFloat writes integral floats in integer form so that they match the
+// integer types; other values use the shortest representation.
+func writeFloat(b *strings.Builder, f float64) {
+	if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+		b.WriteString(strconv.FormatInt(int64(f), 10))
+		return
+	}
+	b.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+func writeString(b *strings.Builder, s string) {
+	data, _ := json.Marshal(s)
+	b.Write(data)
+}
@@ -0,0 +1,53 @@
+// Package intconv converts workflow input numbers to int. Numbers decoded
+// from JSON are float64, and converting one outside the int range is
+// implementation-defined in Go, so huge counts and positions such as 1e30
+// could come out negative. These helpers clamp or reduce the value first.
+package intconv
+
+import (
+	"math"
+)
+
+// Int returns v as an int, truncating fractions and clamping values outside
+// the int range to math.MinInt or math.MaxInt. It reports false for NaN
+// and for values that are not numbers.
+func Int(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		switch {
+		case math.IsNaN(n):
+			return 0, false
+		case n >= math.MaxInt:
+			return math.MaxInt, true
+		case n <= math.MinInt:
+			return math.MinInt, true
+		}
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
+
+// Mod returns v modulo m as an int, with the sign of v, for positive m.
+// Huge floats are reduced before converting, so 1e300 modulo 4 is 0 rather
+// than the remainder of a clamped value. It reports false for NaN,
+// infinities and values that are not numbers.
+func Mod(v interface{}, m int) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n % m, true
+	case int64:
+		return int(n % int64(m)), true
+	case float64:
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return 0, false
+		}
+		return int(math.Mod(math.Trunc(n), float64(m))), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,219 @@
+// Package jsonrpc serves the node plugin registry as JSON-RPC 2.0 over a
+// pair of streams, typically a subprocess's stdin and stdout.
+//
+// Each request and response is a single line of JSON:
+//
+//	{"jsonrpc":"2.0","id":1,"method":"execute","params":{"node":"dict.merge","inputs":{...}}}
+//	{"jsonrpc":"2.0","id":1,"result":{"result":{...}}}
+//
+// Methods:
+//   - execute: params {node, inputs}; result is the node's output map
+//   - nodes: params {category} (optional); result is the list of nodes
+//   - describe: params {node}; result is the node description
+//
+// Node failures are not protocol errors: they are returned in the output map
+// under "error", exactly as the plugin reported them.
+package jsonrpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// Standard JSON-RPC 2.0 error codes.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+)
+
+// maxLineBytes limits the size of a single request line.
+const maxLineBytes = 64 << 20
+
+// Request is a JSON-RPC request. Requests without an id are notifications
+// and receive no response.
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is a JSON-RPC response. Exactly one of Result and Error is set.
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Error is a JSON-RPC error object.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("jsonrpc error %d: %s", e.Code, e.Message)
+}
+
+// Handler answers requests against a registry.
+type Handler struct {
+	Registry *registry.Registry
+
+	// NewRuntime creates the runtime for each execute call. When nil, one
+	// runtime is shared by all calls, so the store persists between them.
+	NewRuntime func() *plugin.Runtime
+
+	shared *plugin.Runtime
+}
+
+// NewHandler creates a handler for the builtin registry with one runtime
+// shared by all calls.
+func NewHandler() *Handler {
+	return &Handler{Registry: registry.Builtin()}
+}
+
+// Serve reads requests from r, one per line, and writes responses to w until
+// r is exhausted. Requests are handled in order. A response that cannot be
+// encoded, such as a node output holding NaN, is replaced by an internal
+// error for that request rather than ending the session.
+func (h *Handler) Serve(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineBytes)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		resp := h.handleLine(line)
+		if resp == nil {
+			continue
+		}
+		data, err := json.Marshal(resp)
+		if err != nil {
+			data, err = json.Marshal(errorResponse(resp.ID, CodeInternalError, "internal error: "+err.Error()))
+			if err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(append(data, '\n')); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// handleLine decodes and answers one request. It returns nil for notifications.
+func (h *Handler) handleLine(line []byte) *Response {
+	var req Request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return errorResponse(nil, CodeParseError, "parse error: "+err.Error())
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, CodeInvalidRequest, `invalid request: "jsonrpc" must be "2.0" and "method" is required`)
+	}
+
+	result, rpcErr := h.Call(req.Method, req.Params)
+	if len(req.ID) == 0 {
+		return nil
+	}
+	if rpcErr != nil {
+		return &Response{JSONRPC: "2.0", ID: req.ID, Error: rpcErr}
+	}
+	return &Response{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+// Call runs one method with raw JSON params.
+func (h *Handler) Call(method string, params json.RawMessage) (interface{}, *Error) {
+	var p struct {
+		Node     string                 `json:"node"`
+		Inputs   map[string]interface{} `json:"inputs"`
+		Category string                 `json:"category"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, &Error{Code: CodeInvalidParams, Message: "invalid params: " + err.Error()}
+		}
+	}
+
+	switch method {
+	case "execute":
+		node, rpcErr := h.lookup(p.Node)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		if p.Inputs == nil {
+			p.Inputs = make(map[string]interface{})
+		}
+		return h.execute(node, p.Inputs)
+	case "describe":
+		return h.lookup(p.Node)
+	case "nodes":
+		nodes := []*registry.Node{}
+		for _, node := range h.Registry.Nodes() {
+			if p.Category == "" || node.Category == p.Category {
+				nodes = append(nodes, node)
+			}
+		}
+		return nodes, nil
+	default:
+		return nil, &Error{Code: CodeMethodNotFound, Message: fmt.Sprintf("method %q not found", method)}
+	}
+}
+
+// execute runs a node, turning a panic into an internal error so that one
+// faulty plugin cannot take down the server.
+func (h *Handler) execute(node *registry.Node, inputs map[string]interface{}) (result interface{}, rpcErr *Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			rpcErr = &Error{Code: CodeInternalError, Message: fmt.Sprintf("node %s panicked: %v", node.NodeType, r)}
+		}
+	}()
+	return node.Executor.Execute(inputs, h.runtime()), nil
+}
+
+// lookup finds a node by type.
+func (h *Handler) lookup(nodeType string) (*registry.Node, *Error) {
+	if nodeType == "" {
+		return nil, &Error{Code: CodeInvalidParams, Message: `invalid params: "node" is required`}
+	}
+	node, ok := h.Registry.Get(nodeType)
+	if !ok {
+		return nil, &Error{Code: CodeInvalidParams, Message: fmt.Sprintf("unknown node type %q", nodeType)}
+	}
+	return node, nil
+}
+
+// runtime returns the runtime for the next execute call.
+func (h *Handler) runtime() *plugin.Runtime {
+	if h.NewRuntime != nil {
+		return h.NewRuntime()
+	}
+	if h.shared == nil {
+		h.shared = &plugin.Runtime{
+			Store:   make(map[string]interface{}),
+			Context: make(map[string]interface{}),
+			Events:  plugin.NewEventBus(),
+		}
+	}
+	return h.shared
+}
+
+// errorResponse builds an error response. A nil id is encoded as null.
+func errorResponse(id json.RawMessage, code int, message string) *Response {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &Response{JSONRPC: "2.0", ID: id, Error: &Error{Code: code, Message: message}}
+}
@@ -0,0 +1,7 @@
+// Package list_aggregate provides factory for ListAggregate plugin.
+package list_aggregate
+
+// Create returns a new ListAggregate instance.
+func Create() *ListAggregate {
+	return NewListAggregate()
+}
@@ -0,0 +1,100 @@
+// Package list_aggregate provides a workflow plugin for list aggregates.
+package list_aggregate
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// ListAggregate implements the NodeExecutor interface for list aggregates.
+type ListAggregate struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListAggregate creates a new ListAggregate instance.
+func NewListAggregate() *ListAggregate {
+	return &ListAggregate{
+		NodeType:    "list.aggregate",
+		Category:    "list",
+		Description: "Compute count, sum, average, min and max of a list",
+	}
+}
+
+// Execute runs the plugin logic.
+// Computes every aggregate in one pass. Any numeric type is accepted;
+// elements that are not numbers (or lack the field) are left out of sum,
+// avg, min and max and counted in skipped. avg, min and max are null when
+// there are no numbers.
+// Inputs:
+//   - list: the list to aggregate
+//   - field: (optional) path of the number to use in object elements (supports dot notation)
+//
+// Returns:
+//   - result: dictionary with count, sum, avg, min and max
+//   - skipped: number of elements that were not numbers
+//   - error: set when field is not a valid path
+func (p *ListAggregate) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+
+	var field paths.Path
+	if s, ok := inputs["field"].(string); ok && s != "" {
+		var err error
+		if field, err = paths.Parse(s); err != nil {
+			return map[string]interface{}{"result": map[string]interface{}{}, "skipped": 0, "error": err.Error()}
+		}
+	}
+
+	var sum float64
+	var min, max interface{}
+	numbers, skipped := 0, 0
+	for _, item := range list {
+		v, _ := paths.Get(item, field)
+		n, ok := toFloat(v)
+		if !ok {
+			skipped++
+			continue
+		}
+		sum += n
+		if numbers == 0 || n < min.(float64) {
+			min = n
+		}
+		if numbers == 0 || n > max.(float64) {
+			max = n
+		}
+		numbers++
+	}
+
+	var avg interface{}
+	if numbers > 0 {
+		avg = sum / float64(numbers)
+	}
+
+	return map[string]interface{}{
+		"result": map[string]interface{}{
+			"count": len(list),
+			"sum":   sum,
+			"avg":   avg,
+			"min":   min,
+			"max":   max,
+		},
+		"skipped": skipped,
+	}
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
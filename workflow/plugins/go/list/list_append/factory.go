@@ -0,0 +1,7 @@
+// Package list_append provides factory for ListAppend plugin.
+package list_append
+
+// Create returns a new ListAppend instance.
+func Create() *ListAppend {
+	return NewListAppend()
+}
@@ -0,0 +1,50 @@
+// Package list_append provides a workflow plugin for adding elements to lists.
+package list_append
+
+// ListAppend implements the NodeExecutor interface for adding elements to lists.
+type ListAppend struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListAppend creates a new ListAppend instance.
+func NewListAppend() *ListAppend {
+	return &ListAppend{
+		NodeType:    "list.append",
+		Category:    "list",
+		Description: "Add elements to the end or start of a list",
+	}
+}
+
+// Execute runs the plugin logic.
+// Returns a new list; the input list is not modified. A missing list
+// starts empty, so results can be accumulated across loop iterations.
+// Inputs:
+//   - list: the list to extend (or nil to start a new one)
+//   - value: (optional) the element to add
+//   - values: (optional) list of elements to add, after value
+//   - prepend: (optional) add at the start instead of the end (default: false)
+//
+// Returns:
+//   - result: the extended list
+func (p *ListAppend) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+
+	var added []interface{}
+	if value, ok := inputs["value"]; ok {
+		added = append(added, value)
+	}
+	if values, ok := inputs["values"].([]interface{}); ok {
+		added = append(added, values...)
+	}
+
+	result := make([]interface{}, 0, len(list)+len(added))
+	if prepend, _ := inputs["prepend"].(bool); prepend {
+		result = append(append(result, added...), list...)
+	} else {
+		result = append(append(result, list...), added...)
+	}
+
+	return map[string]interface{}{"result": result}
+}
@@ -0,0 +1,7 @@
+// Package list_compact provides factory for ListCompact plugin.
+package list_compact
+
+// Create returns a new ListCompact instance.
+func Create() *ListCompact {
+	return NewListCompact()
+}
@@ -0,0 +1,82 @@
+// Package list_compact provides a workflow plugin for removing empty elements from lists.
+package list_compact
+
+import (
+	"fmt"
+)
+
+// ListCompact implements the NodeExecutor interface for removing empty elements from lists.
+type ListCompact struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListCompact creates a new ListCompact instance.
+func NewListCompact() *ListCompact {
+	return &ListCompact{
+		NodeType:    "list.compact",
+		Category:    "list",
+		Description: "Remove null and empty elements from a list",
+	}
+}
+
+// kinds are the values remove accepts, the same as dict.prune.
+var kinds = map[string]bool{"null": true, "empty_string": true, "empty_list": true, "empty_dict": true}
+
+// Execute runs the plugin logic.
+// Drops null and empty elements, keeping the order of the rest. Only the
+// top level is compacted; use dict.prune for nested values.
+// Inputs:
+//   - list: the list to compact
+//   - remove: (optional) list of kinds to remove: null, empty_string, empty_list, empty_dict (default: all)
+//
+// Returns:
+//   - result: the list without the removed elements
+//   - removed: number of removed elements
+//   - error: set when remove contains an unknown kind
+func (p *ListCompact) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+
+	remove := kinds
+	if raw, ok := inputs["remove"].([]interface{}); ok {
+		remove = map[string]bool{}
+		for _, k := range raw {
+			kind := fmt.Sprintf("%v", k)
+			if !kinds[kind] {
+				return map[string]interface{}{"result": []interface{}{}, "removed": 0, "error": fmt.Sprintf("unknown kind %q", kind)}
+			}
+			remove[kind] = true
+		}
+	}
+
+	result := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		if !remove[kind(item)] {
+			result = append(result, item)
+		}
+	}
+
+	return map[string]interface{}{"result": result, "removed": len(list) - len(result)}
+}
+
+// kind returns the removable kind of v, or "" when it is not empty.
+func kind(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		if val == "" {
+			return "empty_string"
+		}
+	case []interface{}:
+		if len(val) == 0 {
+			return "empty_list"
+		}
+	case map[string]interface{}:
+		if len(val) == 0 {
+			return "empty_dict"
+		}
+	}
+	return ""
+}
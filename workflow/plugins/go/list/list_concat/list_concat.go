@@ -18,6 +18,11 @@ func NewListConcat() *ListConcat {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - lists: list of lists to concatenate
+//
+// Returns:
+//   - result: the concatenated list
 func (p *ListConcat) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	lists, ok := inputs["lists"].([]interface{})
 	if !ok {
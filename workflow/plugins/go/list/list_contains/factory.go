@@ -0,0 +1,7 @@
+// Package list_contains provides factory for ListContains plugin.
+package list_contains
+
+// Create returns a new ListContains instance.
+func Create() *ListContains {
+	return NewListContains()
+}
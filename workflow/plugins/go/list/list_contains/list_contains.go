@@ -0,0 +1,53 @@
+// Package list_contains provides a workflow plugin for list membership checks.
+package list_contains
+
+import (
+	"reflect"
+)
+
+// ListContains implements the NodeExecutor interface for list membership checks.
+type ListContains struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListContains creates a new ListContains instance.
+func NewListContains() *ListContains {
+	return &ListContains{
+		NodeType:    "list.contains",
+		Category:    "list",
+		Description: "Check whether a list contains a value",
+	}
+}
+
+// Execute runs the plugin logic.
+// Elements are compared with deep equality, like logic.equals.
+// Inputs:
+//   - list: the list to search
+//   - value: the value to look for
+//   - key: (optional) compare this key of object elements with value
+//
+// Returns:
+//   - result: whether any element matches
+func (p *ListContains) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+	value := inputs["value"]
+	key, hasKey := inputs["key"].(string)
+
+	for _, item := range list {
+		if hasKey {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if v, exists := obj[key]; exists && reflect.DeepEqual(v, value) {
+				return map[string]interface{}{"result": true}
+			}
+		} else if reflect.DeepEqual(item, value) {
+			return map[string]interface{}{"result": true}
+		}
+	}
+
+	return map[string]interface{}{"result": false}
+}
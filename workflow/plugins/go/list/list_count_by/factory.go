@@ -0,0 +1,7 @@
+// Package list_count_by provides factory for ListCountBy plugin.
+package list_count_by
+
+// Create returns a new ListCountBy instance.
+func Create() *ListCountBy {
+	return NewListCountBy()
+}
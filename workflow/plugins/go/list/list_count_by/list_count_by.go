@@ -0,0 +1,145 @@
+// Package list_count_by provides a workflow plugin for counting value occurrences in a list.
+package list_count_by
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/expr"
+	"github.com/metabuilder/workflow-plugins-go/hashing"
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// ListCountBy implements the NodeExecutor interface for counting value occurrences in a list.
+type ListCountBy struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListCountBy creates a new ListCountBy instance.
+func NewListCountBy() *ListCountBy {
+	return &ListCountBy{
+		NodeType:    "list.count_by",
+		Category:    "list",
+		Description: "Count occurrences of values in a list",
+	}
+}
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// Execute runs the plugin logic.
+// Counts each element, the value at key of object elements or the result
+// of an expression evaluated with item and index defined (plus the
+// element's fields when it is an object). Values are counted under their
+// dictionary key: strings as they are, anything else JSON encoded with
+// numbers by value, so 1 and 1.0 count together under "1" (as does the
+// string "1"). Ties for most_common go to the value seen first.
+// Inputs:
+//   - list: the list to analyse
+//   - key: (optional) path of the field to count in object elements (supports dot notation)
+//   - expression: (optional) expression whose result is counted instead of key
+//
+// Returns:
+//   - result: dictionary of value to number of occurrences
+//   - most_common: the most frequent value, or null for an empty list
+//   - most_common_count: occurrences of most_common
+//   - distinct: number of distinct values
+//   - error: set when key or expression is invalid
+func (p *ListCountBy) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(err error) map[string]interface{} {
+		return map[string]interface{}{"result": map[string]interface{}{}, "most_common": nil, "most_common_count": 0, "distinct": 0, "error": err.Error()}
+	}
+	list, _ := inputs["list"].([]interface{})
+
+	var extract func(i int, item interface{}) (interface{}, bool, error)
+	if expression, ok := inputs["expression"].(string); ok && expression != "" {
+		x, err := expr.Parse(expression)
+		if err != nil {
+			return fail(err)
+		}
+		store := storeOf(runtime)
+		extract = func(i int, item interface{}) (interface{}, bool, error) {
+			vars := map[string]interface{}{}
+			if obj, ok := item.(map[string]interface{}); ok {
+				for k, v := range obj {
+					vars[k] = v
+				}
+			}
+			vars["item"], vars["index"] = item, float64(i)
+			v, err := x.Eval(vars, store)
+			return v, true, err
+		}
+	} else {
+		var path paths.Path
+		if key, ok := inputs["key"].(string); ok {
+			var err error
+			if path, err = paths.Parse(key); err != nil {
+				return fail(err)
+			}
+		}
+		extract = func(_ int, item interface{}) (interface{}, bool, error) {
+			v, found := paths.Get(item, path)
+			return v, found, nil
+		}
+	}
+
+	counts := map[string]interface{}{}
+	tally := map[string]int{}
+	// Remember values in order of first appearance to break ties
+	var order []string
+	first := map[string]interface{}{}
+	for i, item := range list {
+		v, found, err := extract(i, item)
+		if err != nil {
+			return fail(fmt.Errorf("element %d: %v", i, err))
+		}
+		if !found {
+			continue
+		}
+		k := label(v)
+		if tally[k] == 0 {
+			order = append(order, k)
+			first[k] = v
+		}
+		tally[k]++
+		counts[k] = tally[k]
+	}
+
+	var mostCommon interface{}
+	best := 0
+	for _, k := range order {
+		if tally[k] > best {
+			best, mostCommon = tally[k], first[k]
+		}
+	}
+
+	return map[string]interface{}{
+		"result":            counts,
+		"most_common":       mostCommon,
+		"most_common_count": best,
+		"distinct":          len(tally),
+	}
+}
+
+// label returns the dictionary key for a counted value.
+func label(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return hashing.Key(v)
+}
+
+func storeOf(runtime interface{}) map[string]interface{} {
+	if r, ok := runtime.(Runtime); ok {
+		return r.GetStore()
+	}
+	if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			return s
+		}
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+// Package list_difference provides factory for ListDifference plugin.
+package list_difference
+
+// Create returns a new ListDifference instance.
+func Create() *ListDifference {
+	return NewListDifference()
+}
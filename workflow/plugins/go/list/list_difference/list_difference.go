@@ -0,0 +1,87 @@
+// Package list_difference provides a workflow plugin for subtracting lists.
+package list_difference
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/hashing"
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// ListDifference implements the NodeExecutor interface for subtracting lists.
+type ListDifference struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListDifference creates a new ListDifference instance.
+func NewListDifference() *ListDifference {
+	return &ListDifference{
+		NodeType:    "list.difference",
+		Category:    "list",
+		Description: "Elements of the first list absent from the others",
+	}
+}
+
+// Execute runs the plugin logic.
+// Keeps the elements of the first list that occur in none of the other
+// lists, in the order of the first list. Each element appears once; for
+// duplicates the first occurrence is kept.
+// Inputs:
+//   - lists: the lists to combine
+//   - key: (optional) path of the field to compare object elements by instead of as a whole, like list.unique
+//
+// Returns:
+//   - result: elements only in the first list
+//   - error: set if lists is not a list of lists or key is not a valid path
+func (p *ListDifference) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	lists, errMsg := listsOf(inputs["lists"])
+	if errMsg != "" {
+		return map[string]interface{}{"result": []interface{}{}, "error": errMsg}
+	}
+	var keys []paths.Path
+	if k, ok := inputs["key"].(string); ok {
+		key, err := paths.Parse(k)
+		if err != nil {
+			return map[string]interface{}{"result": []interface{}{}, "error": err.Error()}
+		}
+		keys = append(keys, key)
+	}
+
+	result := []interface{}{}
+	if len(lists) == 0 {
+		return map[string]interface{}{"result": result}
+	}
+
+	exclude := make(map[string]bool)
+	for _, list := range lists[1:] {
+		for _, item := range list {
+			exclude[hashing.Identity(item, keys...)] = true
+		}
+	}
+
+	for _, item := range lists[0] {
+		id := hashing.Identity(item, keys...)
+		if !exclude[id] {
+			exclude[id] = true
+			result = append(result, item)
+		}
+	}
+
+	return map[string]interface{}{"result": result}
+}
+
+func listsOf(v interface{}) ([][]interface{}, string) {
+	raw, ok := v.([]interface{})
+	if !ok {
+		return nil, "lists must be an array of arrays"
+	}
+	lists := make([][]interface{}, len(raw))
+	for i, l := range raw {
+		if lists[i], ok = l.([]interface{}); !ok {
+			return nil, fmt.Sprintf("lists[%d] must be an array", i)
+		}
+	}
+	return lists, ""
+}
@@ -0,0 +1,7 @@
+// Package list_drop_while provides factory for ListDropWhile plugin.
+package list_drop_while
+
+// Create returns a new ListDropWhile instance.
+func Create() *ListDropWhile {
+	return NewListDropWhile()
+}
@@ -0,0 +1,7 @@
+// Package list_filter provides factory for ListFilter plugin.
+package list_filter
+
+// Create returns a new ListFilter instance.
+func Create() *ListFilter {
+	return NewListFilter()
+}
@@ -0,0 +1,160 @@
+// Package list_filter provides a workflow plugin for filtering lists.
+package list_filter
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+	"github.com/metabuilder/workflow-plugins-go/predicate"
+)
+
+// ListFilter implements the NodeExecutor interface for filtering lists.
+type ListFilter struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListFilter creates a new ListFilter instance.
+func NewListFilter() *ListFilter {
+	return &ListFilter{
+		NodeType:    "list.filter",
+		Category:    "list",
+		Description: "Keep list elements matching a condition",
+	}
+}
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// Execute runs the plugin logic.
+// Keeps the elements that satisfy a condition. Without an expression, each
+// element (or its field, for objects) is compared with value using op;
+// numbers compare by value, so 1 equals 1.0. An expression is evaluated
+// for each element with item and index defined, plus the element's fields
+// when it is an object, e.g. "price > 10 && inStock".
+// Inputs:
+//   - list: the list to filter
+//   - key: (optional) path of the field to compare in object elements (supports dot notation)
+//   - op: (optional) eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists
+//   - value: (optional) the value to compare with; a list for in, a pattern for regex
+//   - expression: (optional) condition evaluated per element instead of key/op/value
+//   - invert: (optional) keep the elements that do not match instead (default: false)
+//   - concurrency: (optional) number of elements tested at the same time, at most the number of CPUs (default: 1)
+//
+// Returns:
+//   - result: the matching elements
+//   - count: number of matching elements
+//   - error: set when key, op, pattern or expression is invalid
+func (p *ListFilter) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, ok := inputs["list"].([]interface{})
+	if !ok {
+		return map[string]interface{}{"result": []interface{}{}, "count": 0}
+	}
+	invert, _ := inputs["invert"].(bool)
+
+	match, err := matcher(inputs, runtime)
+	if err != nil {
+		return map[string]interface{}{"result": []interface{}{}, "count": 0, "error": err.Error()}
+	}
+
+	concurrency := 1
+	if n, ok := toInt(inputs["concurrency"]); ok && n > 1 {
+		concurrency = n
+	}
+	matched, errs := evaluate(list, match, concurrency)
+
+	result := make([]interface{}, 0, len(list))
+	for i, item := range list {
+		if errs[i] != nil {
+			return map[string]interface{}{"result": []interface{}{}, "count": 0, "error": fmt.Sprintf("element %d: %v", i, errs[i])}
+		}
+		if matched[i] != invert {
+			result = append(result, item)
+		}
+	}
+
+	return map[string]interface{}{"result": result, "count": len(result)}
+}
+
+// evaluate tests every element, spreading the work over up to concurrency
+// goroutines, but no more than GOMAXPROCS since the tests are CPU-bound.
+// Results are stored by index, so the order of the list is kept whatever
+// order the tests finish in.
+func evaluate(list []interface{}, match predicate.Func, concurrency int) ([]bool, []error) {
+	matched := make([]bool, len(list))
+	errs := make([]error, len(list))
+	concurrency = min(concurrency, len(list), runtime.GOMAXPROCS(0))
+	if concurrency <= 1 {
+		for i, item := range list {
+			if matched[i], errs[i] = match(i, item); errs[i] != nil {
+				break
+			}
+		}
+		return matched, errs
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				matched[i], errs[i] = match(i, list[i])
+			}
+		}()
+	}
+	for i := range list {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return matched, errs
+}
+
+// matcher builds the element test described by the inputs.
+func matcher(inputs map[string]interface{}, runtime interface{}) (predicate.Func, error) {
+	if expression, ok := inputs["expression"].(string); ok && expression != "" {
+		return predicate.Expression(expression, storeOf(runtime))
+	}
+
+	var path paths.Path
+	if key, ok := inputs["key"].(string); ok && key != "" {
+		var err error
+		if path, err = paths.Parse(key); err != nil {
+			return nil, err
+		}
+	}
+	op, _ := inputs["op"].(string)
+	return predicate.Field(path, op, inputs["value"])
+}
+
+func storeOf(runtime interface{}) map[string]interface{} {
+	if r, ok := runtime.(Runtime); ok {
+		return r.GetStore()
+	}
+	if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			return s
+		}
+	}
+	return nil
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
@@ -1,6 +1,11 @@
 // Package list_find provides a workflow plugin for finding elements in lists.
 package list_find
 
+import (
+	"github.com/metabuilder/workflow-plugins-go/paths"
+	"github.com/metabuilder/workflow-plugins-go/predicate"
+)
+
 // ListFind implements the NodeExecutor interface for finding elements in lists.
 type ListFind struct {
 	NodeType    string
@@ -18,36 +23,46 @@ func NewListFind() *ListFind {
 }
 
 // Execute runs the plugin logic.
+// Each element (or its field, for objects) is compared with value using
+// op, with the same operators as list.filter; numbers compare by value, so
+// 1 equals 1.0.
 // Inputs:
 //   - list: the list to search
-//   - key: (optional) the key to match in objects
+//   - key: (optional) path of the field to match in objects (supports dot notation)
+//   - op: (optional) eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists
 //   - value: the value to match (or condition value)
 //
 // Returns:
 //   - result: the first matching element or nil
 //   - index: the index of the match or -1
+//   - error: set when key is not a valid path or op or value is invalid
 func (p *ListFind) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	list, ok := inputs["list"].([]interface{})
 	if !ok {
 		return map[string]interface{}{"result": nil, "index": -1}
 	}
 
-	value := inputs["value"]
+	var path paths.Path
 	key, hasKey := inputs["key"].(string)
+	if hasKey {
+		var err error
+		if path, err = paths.Parse(key); err != nil {
+			return map[string]interface{}{"result": nil, "index": -1, "error": err.Error()}
+		}
+	}
+	op, _ := inputs["op"].(string)
+	match, err := predicate.Field(path, op, inputs["value"])
+	if err != nil {
+		return map[string]interface{}{"result": nil, "index": -1, "error": err.Error()}
+	}
 
 	for i, item := range list {
-		if hasKey {
-			// Search by key/value in objects
-			if obj, ok := item.(map[string]interface{}); ok {
-				if objVal, exists := obj[key]; exists && objVal == value {
-					return map[string]interface{}{"result": item, "index": i}
-				}
-			}
-		} else {
-			// Direct value comparison
-			if item == value {
-				return map[string]interface{}{"result": item, "index": i}
-			}
+		if _, isObj := item.(map[string]interface{}); hasKey && !isObj {
+			// Keys only apply to objects
+			continue
+		}
+		if ok, _ := match(i, item); ok {
+			return map[string]interface{}{"result": item, "index": i}
 		}
 	}
 
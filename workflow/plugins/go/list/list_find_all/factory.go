@@ -0,0 +1,7 @@
+// Package list_find_all provides factory for ListFindAll plugin.
+package list_find_all
+
+// Create returns a new ListFindAll instance.
+func Create() *ListFindAll {
+	return NewListFindAll()
+}
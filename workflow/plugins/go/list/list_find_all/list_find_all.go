@@ -0,0 +1,133 @@
+// Package list_find_all provides a workflow plugin for finding every matching element in a list.
+package list_find_all
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+	"github.com/metabuilder/workflow-plugins-go/predicate"
+)
+
+// ListFindAll implements the NodeExecutor interface for finding every matching element in a list.
+type ListFindAll struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListFindAll creates a new ListFindAll instance.
+func NewListFindAll() *ListFindAll {
+	return &ListFindAll{
+		NodeType:    "list.find_all",
+		Category:    "list",
+		Description: "Find every element matching a condition",
+	}
+}
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// Execute runs the plugin logic.
+// Matches elements like list.find (key, op and value) or, when expression
+// is set, like the expressions of list.filter. The search stops once
+// max_results elements are found.
+// Inputs:
+//   - list: the list to search
+//   - key: (optional) path of the field to match in objects (supports dot notation)
+//   - op: (optional) eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists
+//   - value: (optional) the value to match (or condition value)
+//   - expression: (optional) condition evaluated per element instead of key/op/value
+//   - max_results: (optional) stop after this many matches (default: no limit)
+//
+// Returns:
+//   - result: the matching elements
+//   - indices: the index of each matching element
+//   - count: number of matching elements
+//   - truncated: true when max_results stopped the search early
+//   - error: set when key, op, value or expression is invalid
+func (p *ListFindAll) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(err error) map[string]interface{} {
+		return map[string]interface{}{"result": []interface{}{}, "indices": []interface{}{}, "count": 0, "truncated": false, "error": err.Error()}
+	}
+	list, _ := inputs["list"].([]interface{})
+
+	var hasKey bool
+	var match predicate.Func
+	var err error
+	if expression, ok := inputs["expression"].(string); ok && expression != "" {
+		match, err = predicate.Expression(expression, storeOf(runtime))
+	} else {
+		var path paths.Path
+		var key string
+		if key, hasKey = inputs["key"].(string); hasKey {
+			if path, err = paths.Parse(key); err != nil {
+				return fail(err)
+			}
+		}
+		op, _ := inputs["op"].(string)
+		match, err = predicate.Field(path, op, inputs["value"])
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	limit := -1
+	if n, ok := toInt(inputs["max_results"]); ok {
+		if n < 0 {
+			return fail(fmt.Errorf("max_results must not be negative"))
+		}
+		limit = n
+	}
+
+	result := []interface{}{}
+	indices := []interface{}{}
+	truncated := false
+	for i, item := range list {
+		if _, isObj := item.(map[string]interface{}); hasKey && !isObj {
+			// Keys only apply to objects
+			continue
+		}
+		ok, err := match(i, item)
+		if err != nil {
+			return fail(fmt.Errorf("element %d: %v", i, err))
+		}
+		if !ok {
+			continue
+		}
+		if len(result) == limit {
+			truncated = true
+			break
+		}
+		result = append(result, item)
+		indices = append(indices, i)
+	}
+
+	return map[string]interface{}{"result": result, "indices": indices, "count": len(result), "truncated": truncated}
+}
+
+func storeOf(runtime interface{}) map[string]interface{} {
+	if r, ok := runtime.(Runtime); ok {
+		return r.GetStore()
+	}
+	if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			return s
+		}
+	}
+	return nil
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
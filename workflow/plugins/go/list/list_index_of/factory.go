@@ -0,0 +1,7 @@
+// Package list_index_of provides factory for ListIndexOf plugin.
+package list_index_of
+
+// Create returns a new ListIndexOf instance.
+func Create() *ListIndexOf {
+	return NewListIndexOf()
+}
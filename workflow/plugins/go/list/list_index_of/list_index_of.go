@@ -0,0 +1,69 @@
+// Package list_index_of provides a workflow plugin for locating list elements.
+package list_index_of
+
+import (
+	"reflect"
+)
+
+// ListIndexOf implements the NodeExecutor interface for locating list elements.
+type ListIndexOf struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListIndexOf creates a new ListIndexOf instance.
+func NewListIndexOf() *ListIndexOf {
+	return &ListIndexOf{
+		NodeType:    "list.index_of",
+		Category:    "list",
+		Description: "Find the position of a value in a list",
+	}
+}
+
+// Execute runs the plugin logic.
+// Elements are compared with deep equality, like logic.equals.
+// Inputs:
+//   - list: the list to search
+//   - value: the value to find
+//   - key: (optional) compare this key of object elements with value
+//   - all: (optional) also return the indices of every match (default: false)
+//
+// Returns:
+//   - index: the index of the first match or -1
+//   - indices: (optional) the indices of every match, when all is set
+func (p *ListIndexOf) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+	value := inputs["value"]
+	key, hasKey := inputs["key"].(string)
+	all, _ := inputs["all"].(bool)
+
+	indices := []interface{}{}
+	for i, item := range list {
+		candidate := item
+		if hasKey {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if candidate, ok = obj[key]; !ok {
+				continue
+			}
+		}
+		if reflect.DeepEqual(candidate, value) {
+			if !all {
+				return map[string]interface{}{"index": i}
+			}
+			indices = append(indices, i)
+		}
+	}
+
+	index := -1
+	if len(indices) > 0 {
+		index = indices[0].(int)
+	}
+	if all {
+		return map[string]interface{}{"index": index, "indices": indices}
+	}
+	return map[string]interface{}{"index": index}
+}
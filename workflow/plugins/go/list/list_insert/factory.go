@@ -0,0 +1,7 @@
+// Package list_insert provides factory for ListInsert plugin.
+package list_insert
+
+// Create returns a new ListInsert instance.
+func Create() *ListInsert {
+	return NewListInsert()
+}
@@ -0,0 +1,72 @@
+// Package list_insert provides a workflow plugin for inserting list elements.
+package list_insert
+
+import (
+	"fmt"
+)
+
+// ListInsert implements the NodeExecutor interface for inserting list elements.
+type ListInsert struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListInsert creates a new ListInsert instance.
+func NewListInsert() *ListInsert {
+	return &ListInsert{
+		NodeType:    "list.insert",
+		Category:    "list",
+		Description: "Insert an element into a list at an index",
+	}
+}
+
+// Execute runs the plugin logic.
+// Inserts value before the element at index; an index equal to the list
+// length appends. Negative indices count from the end, so -1 inserts
+// before the last element. The input list is not modified.
+// Inputs:
+//   - list: the list to insert into
+//   - index: the position to insert at
+//   - value: the element to insert
+//
+// Returns:
+//   - result: the list with the element inserted
+//   - error: set when the index is out of range
+func (p *ListInsert) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+
+	index, ok := toInt(inputs["index"])
+	if !ok {
+		return map[string]interface{}{"result": list, "error": "index must be an integer"}
+	}
+	if index < 0 {
+		index += len(list)
+	}
+	if index < 0 || index > len(list) {
+		return map[string]interface{}{"result": list, "error": fmt.Sprintf("index %v out of range for list of length %d", inputs["index"], len(list))}
+	}
+
+	result := make([]interface{}, 0, len(list)+1)
+	result = append(result, list[:index]...)
+	result = append(result, inputs["value"])
+	result = append(result, list[index:]...)
+
+	return map[string]interface{}{"result": result}
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		if n != float64(int(n)) {
+			return 0, false
+		}
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,7 @@
+// Package list_interleave provides factory for ListInterleave plugin.
+package list_interleave
+
+// Create returns a new ListInterleave instance.
+func Create() *ListInterleave {
+	return NewListInterleave()
+}
@@ -0,0 +1,94 @@
+// Package list_interleave provides a workflow plugin for interleaving lists.
+package list_interleave
+
+import (
+	"fmt"
+)
+
+// ListInterleave implements the NodeExecutor interface for interleaving lists.
+type ListInterleave struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListInterleave creates a new ListInterleave instance.
+func NewListInterleave() *ListInterleave {
+	return &ListInterleave{
+		NodeType:    "list.interleave",
+		Category:    "list",
+		Description: "Merge lists by taking elements from each in turn",
+	}
+}
+
+// Execute runs the plugin logic.
+// Takes the first element of each list, then the second of each, and so
+// on: [a1, a2] and [b1, b2, b3] give [a1, b1, a2, b2, b3]. uneven decides
+// what happens once a list runs out:
+//   - skip: continue with the remaining lists
+//   - stop: stop at the end of the shortest list
+//   - pad: use fill in place of the missing elements
+//
+// Inputs:
+//   - lists: the lists to interleave
+//   - uneven: (optional) "skip", "stop" or "pad" (default: "skip")
+//   - fill: (optional) value used for missing elements with pad (default: null)
+//
+// Returns:
+//   - result: the interleaved list
+//   - error: set if lists is not a list of lists or uneven is invalid
+func (p *ListInterleave) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": []interface{}{}, "error": fmt.Sprintf(format, args...)}
+	}
+	raw, ok := inputs["lists"].([]interface{})
+	if !ok {
+		return fail("lists must be an array of arrays")
+	}
+	lists := make([][]interface{}, len(raw))
+	for i, l := range raw {
+		if lists[i], ok = l.([]interface{}); !ok {
+			return fail("lists[%d] must be an array", i)
+		}
+	}
+
+	uneven := "skip"
+	if s, ok := inputs["uneven"].(string); ok && s != "" {
+		uneven = s
+	}
+	shortest, longest, total := -1, 0, 0
+	for _, l := range lists {
+		if shortest < 0 || len(l) < shortest {
+			shortest = len(l)
+		}
+		if len(l) > longest {
+			longest = len(l)
+		}
+		total += len(l)
+	}
+	rounds := longest
+	switch uneven {
+	case "skip":
+	case "stop":
+		rounds = shortest
+		total = shortest * len(lists)
+	case "pad":
+		total = longest * len(lists)
+	default:
+		return fail("uneven must be \"skip\", \"stop\" or \"pad\"")
+	}
+
+	result := make([]interface{}, 0, total)
+	for i := 0; i < rounds; i++ {
+		for _, l := range lists {
+			switch {
+			case i < len(l):
+				result = append(result, l[i])
+			case uneven == "pad":
+				result = append(result, inputs["fill"])
+			}
+		}
+	}
+
+	return map[string]interface{}{"result": result}
+}
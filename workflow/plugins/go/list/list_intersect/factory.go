@@ -0,0 +1,7 @@
+// Package list_intersect provides factory for ListIntersect plugin.
+package list_intersect
+
+// Create returns a new ListIntersect instance.
+func Create() *ListIntersect {
+	return NewListIntersect()
+}
@@ -0,0 +1,94 @@
+// Package list_intersect provides a workflow plugin for intersecting lists.
+package list_intersect
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/hashing"
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// ListIntersect implements the NodeExecutor interface for intersecting lists.
+type ListIntersect struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListIntersect creates a new ListIntersect instance.
+func NewListIntersect() *ListIntersect {
+	return &ListIntersect{
+		NodeType:    "list.intersect",
+		Category:    "list",
+		Description: "Elements present in every list",
+	}
+}
+
+// Execute runs the plugin logic.
+// Keeps the elements of the first list that occur in every other list, in
+// the order of the first list. Each element appears once; for duplicates
+// the first occurrence is kept.
+// Inputs:
+//   - lists: the lists to combine
+//   - key: (optional) path of the field to compare object elements by instead of as a whole, like list.unique
+//
+// Returns:
+//   - result: elements common to all lists
+//   - error: set if lists is not a list of lists or key is not a valid path
+func (p *ListIntersect) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	lists, errMsg := listsOf(inputs["lists"])
+	if errMsg != "" {
+		return map[string]interface{}{"result": []interface{}{}, "error": errMsg}
+	}
+	var keys []paths.Path
+	if k, ok := inputs["key"].(string); ok {
+		key, err := paths.Parse(k)
+		if err != nil {
+			return map[string]interface{}{"result": []interface{}{}, "error": err.Error()}
+		}
+		keys = append(keys, key)
+	}
+
+	result := []interface{}{}
+	if len(lists) == 0 {
+		return map[string]interface{}{"result": result}
+	}
+
+	// count in how many of the other lists each identity occurs
+	counts := make(map[string]int)
+	for _, list := range lists[1:] {
+		seen := make(map[string]bool)
+		for _, item := range list {
+			id := hashing.Identity(item, keys...)
+			if !seen[id] {
+				seen[id] = true
+				counts[id]++
+			}
+		}
+	}
+
+	emitted := make(map[string]bool)
+	for _, item := range lists[0] {
+		id := hashing.Identity(item, keys...)
+		if counts[id] == len(lists)-1 && !emitted[id] {
+			emitted[id] = true
+			result = append(result, item)
+		}
+	}
+
+	return map[string]interface{}{"result": result}
+}
+
+func listsOf(v interface{}) ([][]interface{}, string) {
+	raw, ok := v.([]interface{})
+	if !ok {
+		return nil, "lists must be an array of arrays"
+	}
+	lists := make([][]interface{}, len(raw))
+	for i, l := range raw {
+		if lists[i], ok = l.([]interface{}); !ok {
+			return nil, fmt.Sprintf("lists[%d] must be an array", i)
+		}
+	}
+	return lists, ""
+}
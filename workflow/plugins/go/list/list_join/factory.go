@@ -0,0 +1,7 @@
+// Package list_join provides factory for ListJoin plugin.
+package list_join
+
+// Create returns a new ListJoin instance.
+func Create() *ListJoin {
+	return NewListJoin()
+}
@@ -0,0 +1,81 @@
+// Package list_join provides a workflow plugin for joining list elements.
+package list_join
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ListJoin implements the NodeExecutor interface for joining list elements.
+type ListJoin struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListJoin creates a new ListJoin instance.
+func NewListJoin() *ListJoin {
+	return &ListJoin{
+		NodeType:    "list.join",
+		Category:    "list",
+		Description: "Join list elements into a string",
+	}
+}
+
+// Execute runs the plugin logic.
+// Elements are stringified the same way as convert.to_string: strings as
+// is, null as "", numbers and booleans in their JSON form and lists and
+// dictionaries JSON encoded.
+// Inputs:
+//   - list: the list to join
+//   - separator: (optional) string placed between elements (default: ", ")
+//   - last_separator: (optional) string placed before the last element instead of separator, e.g. " and "
+//
+// Returns:
+//   - result: the joined string
+//   - error: set if list is not a list
+func (p *ListJoin) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, ok := inputs["list"].([]interface{})
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "list must be an array"}
+	}
+
+	separator := ", "
+	if s, ok := inputs["separator"].(string); ok {
+		separator = s
+	}
+	last := separator
+	if s, ok := inputs["last_separator"].(string); ok {
+		last = s
+	}
+
+	var b strings.Builder
+	for i, item := range list {
+		switch {
+		case i == 0:
+		case i == len(list)-1:
+			b.WriteString(last)
+		default:
+			b.WriteString(separator)
+		}
+		b.WriteString(stringify(item))
+	}
+
+	return map[string]interface{}{"result": b.String()}
+}
+
+func stringify(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case []byte:
+		return string(s)
+	case nil:
+		return ""
+	}
+	if data, err := json.Marshal(v); err == nil {
+		return string(data)
+	}
+	return fmt.Sprintf("%v", v)
+}
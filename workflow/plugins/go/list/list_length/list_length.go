@@ -18,6 +18,11 @@ func NewListLength() *ListLength {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - list: the list to measure
+//
+// Returns:
+//   - result: the number of elements
 func (p *ListLength) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	list, ok := inputs["list"].([]interface{})
 	if !ok {
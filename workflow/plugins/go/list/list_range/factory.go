@@ -0,0 +1,7 @@
+// Package list_range provides factory for ListRange plugin.
+package list_range
+
+// Create returns a new ListRange instance.
+func Create() *ListRange {
+	return NewListRange()
+}
@@ -0,0 +1,238 @@
+// Package list_range provides a workflow plugin for generating sequences.
+package list_range
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListRange implements the NodeExecutor interface for generating sequences.
+type ListRange struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListRange creates a new ListRange instance.
+func NewListRange() *ListRange {
+	return &ListRange{
+		NodeType:    "list.range",
+		Category:    "list",
+		Description: "Generate a sequence of numbers or dates",
+	}
+}
+
+// maxLength bounds the generated list so a wrong step cannot exhaust memory.
+const maxLength = 100000
+
+// Execute runs the plugin logic.
+// Generates numbers from start towards end, like Python's range but with
+// fractional steps allowed. When start and end are dates ("2024-01-31" or
+// RFC 3339 timestamps) it generates dates instead, step units apart, in
+// the format of start. The end is excluded unless inclusive is set.
+// Inputs:
+//   - start: (optional) first value, a number (default: 0) or a date
+//   - end: where to stop, a number or a date
+//   - step: (optional) distance between values; default 1, or -1 when end is below start
+//   - unit: (optional) for dates: second, minute, hour, day (default), week, month or year; second, minute and hour need a start with a time
+//   - inclusive: (optional) include end when the sequence reaches it (default: false)
+//
+// Returns:
+//   - result: the generated list
+//   - error: set when the bounds are invalid, step is zero or the list would exceed 100000 elements
+func (p *ListRange) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	inclusive, _ := inputs["inclusive"].(bool)
+
+	var result []interface{}
+	var err error
+	if endDate, ok := inputs["end"].(string); ok {
+		result, err = dateRange(inputs["start"], endDate, inputs["step"], inputs["unit"], inclusive)
+	} else {
+		result, err = numberRange(inputs["start"], inputs["end"], inputs["step"], inclusive)
+	}
+	if err != nil {
+		return map[string]interface{}{"result": []interface{}{}, "error": err.Error()}
+	}
+	return map[string]interface{}{"result": result}
+}
+
+func numberRange(rawStart, rawEnd, rawStep interface{}, inclusive bool) ([]interface{}, error) {
+	start := 0.0
+	if rawStart != nil {
+		n, ok := toFloat(rawStart)
+		if !ok {
+			return nil, fmt.Errorf("start must be a number")
+		}
+		start = n
+	}
+	end, ok := toFloat(rawEnd)
+	if !ok {
+		return nil, fmt.Errorf("end must be a number")
+	}
+	step := 1.0
+	if end < start {
+		step = -1
+	}
+	if rawStep != nil {
+		if step, ok = toFloat(rawStep); !ok || step == 0 {
+			return nil, fmt.Errorf("step must be a non-zero number")
+		}
+	}
+
+	// Compute each value from its position to avoid accumulating rounding errors
+	count := (end - start) / step
+	if count < 0 {
+		return []interface{}{}, nil
+	}
+	if math.IsInf(count, 0) || count > maxLength {
+		return nil, fmt.Errorf("range would have more than %d elements", maxLength)
+	}
+	// A count within rounding error of a whole number is taken as whole, so
+	// that 0 to 5e-11 in steps of 1e-11 has 5 elements rather than 6
+	if r := math.Round(count); math.Abs(count-r) < 1e-9*math.Max(1, r) {
+		count = r
+	}
+	n := int(math.Ceil(count))
+	if inclusive && count == math.Trunc(count) {
+		n++
+	}
+	if n > maxLength {
+		return nil, fmt.Errorf("range would have %d elements, more than %d", n, maxLength)
+	}
+
+	// Round each value to the decimal places of start and step, which
+	// drops binary noise such as 0.30000000000000004 at any scale
+	places := max(decimalPlaces(start), decimalPlaces(step))
+	result := make([]interface{}, n)
+	for i := range result {
+		v := start + float64(i)*step
+		if places > 0 {
+			v, _ = strconv.ParseFloat(strconv.FormatFloat(v, 'f', places, 64), 64)
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+// decimalPlaces returns the number of digits after the decimal point in the
+// shortest representation of f.
+func decimalPlaces(f float64) int {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+func dateRange(rawStart interface{}, rawEnd string, rawStep, rawUnit interface{}, inclusive bool) ([]interface{}, error) {
+	startText, ok := rawStart.(string)
+	if !ok {
+		return nil, fmt.Errorf("start must be a date when end is a date")
+	}
+	start, layout, err := parseDate(startText)
+	if err != nil {
+		return nil, fmt.Errorf("start: %v", err)
+	}
+	end, _, err := parseDate(rawEnd)
+	if err != nil {
+		return nil, fmt.Errorf("end: %v", err)
+	}
+
+	step := 1
+	if end.Before(start) {
+		step = -1
+	}
+	if rawStep != nil {
+		n, ok := toFloat(rawStep)
+		if !ok || n == 0 || n != math.Trunc(n) {
+			return nil, fmt.Errorf("step must be a non-zero integer for dates")
+		}
+		step = int(n)
+	}
+	unit, _ := rawUnit.(string)
+	if unit == "" {
+		unit = "day"
+	}
+
+	// A plain date cannot show the time of day, so every value would repeat
+	if layout == "2006-01-02" && (unit == "second" || unit == "minute" || unit == "hour") {
+		return nil, fmt.Errorf("unit %q needs start to include a time, not just a date", unit)
+	}
+
+	var add func(t time.Time, n int) time.Time
+	switch unit {
+	case "second", "minute", "hour", "day", "week":
+		d := map[string]time.Duration{"second": time.Second, "minute": time.Minute, "hour": time.Hour, "day": 24 * time.Hour, "week": 7 * 24 * time.Hour}[unit]
+		add = func(t time.Time, n int) time.Time { return t.Add(time.Duration(n) * d) }
+	case "month":
+		add = func(t time.Time, n int) time.Time { return addMonths(t, n) }
+	case "year":
+		add = func(t time.Time, n int) time.Time { return addMonths(t, 12*n) }
+	default:
+		return nil, fmt.Errorf("unknown unit %q", unit)
+	}
+
+	result := []interface{}{}
+	for i := 0; ; i++ {
+		t := add(start, i*step)
+		before := t.Before(end) || (inclusive && t.Equal(end))
+		if step < 0 {
+			before = t.After(end) || (inclusive && t.Equal(end))
+		}
+		if !before {
+			break
+		}
+		if len(result) == maxLength {
+			return nil, fmt.Errorf("range would have more than %d elements", maxLength)
+		}
+		result = append(result, t.Format(layout))
+	}
+	return result, nil
+}
+
+// addMonths moves t by n calendar months, keeping the day of the month but
+// clamping it to the last day of shorter months (Jan 31 + 1 month is Feb 29
+// or 28, not Mar 2).
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()).AddDate(0, n, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// parseDate accepts RFC 3339 timestamps and plain dates, returning the
+// layout that matched so results can be formatted the same way.
+func parseDate(s string) (time.Time, string, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			if layout == time.RFC3339Nano {
+				layout = time.RFC3339
+			}
+			return t, layout, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("%q is not a date", s)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
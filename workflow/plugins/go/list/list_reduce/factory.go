@@ -0,0 +1,7 @@
+// Package list_reduce provides factory for ListReduce plugin.
+package list_reduce
+
+// Create returns a new ListReduce instance.
+func Create() *ListReduce {
+	return NewListReduce()
+}
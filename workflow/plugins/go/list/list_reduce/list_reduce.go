@@ -0,0 +1,280 @@
+// Package list_reduce provides a workflow plugin for folding lists.
+package list_reduce
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/metabuilder/workflow-plugins-go/expr"
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// ListReduce implements the NodeExecutor interface for folding lists.
+type ListReduce struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListReduce creates a new ListReduce instance.
+func NewListReduce() *ListReduce {
+	return &ListReduce{
+		NodeType:    "list.reduce",
+		Category:    "list",
+		Description: "Fold a list into a single value",
+	}
+}
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// Execute runs the plugin logic.
+// Folds a list into one value, starting from initial. Built-in reducers:
+// sum and product of numbers, min and max of numbers or strings, concat of
+// strings or lists, and merge of dictionaries (later keys win). An
+// expression is evaluated for each element with acc, item and index
+// defined, and its value becomes the new accumulator, e.g. "acc + item.qty".
+// Inputs:
+//   - list: the list to fold
+//   - reducer: (optional) sum, product, min, max, concat or merge; required without expression
+//   - expression: (optional) custom reducer expression
+//   - initial: (optional) starting accumulator; defaults to 0 for sum, 1 for product, "" or [] for concat, {} for merge, and the first element for min, max and expressions
+//   - field: (optional) path of the value to fold in object elements (supports dot notation)
+//
+// Returns:
+//   - result: the folded value
+//   - error: set when the reducer is unknown or an element has the wrong type
+func (p *ListReduce) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, ok := inputs["list"].([]interface{})
+	if !ok {
+		list = []interface{}{}
+	}
+
+	if s, ok := inputs["field"].(string); ok && s != "" {
+		field, err := paths.Parse(s)
+		if err != nil {
+			return map[string]interface{}{"result": nil, "error": err.Error()}
+		}
+		values := make([]interface{}, len(list))
+		for i, item := range list {
+			values[i], _ = paths.Get(item, field)
+		}
+		list = values
+	}
+
+	initial, hasInitial := inputs["initial"]
+
+	if expression, ok := inputs["expression"].(string); ok && expression != "" {
+		x, err := expr.Parse(expression)
+		if err != nil {
+			return map[string]interface{}{"result": nil, "error": err.Error()}
+		}
+		store := storeOf(runtime)
+		acc, start := initial, 0
+		if !hasInitial && len(list) > 0 {
+			acc, start = list[0], 1
+		}
+		for i := start; i < len(list); i++ {
+			vars := map[string]interface{}{"acc": acc, "item": list[i], "index": float64(i)}
+			if acc, err = x.Eval(vars, store); err != nil {
+				return map[string]interface{}{"result": nil, "error": fmt.Sprintf("element %d: %v", i, err)}
+			}
+		}
+		return map[string]interface{}{"result": acc}
+	}
+
+	reducer, _ := inputs["reducer"].(string)
+	var result interface{}
+	var err error
+	switch reducer {
+	case "sum", "product":
+		result, err = arithmetic(reducer, list, initial, hasInitial)
+	case "min", "max":
+		result, err = extreme(reducer, list, initial, hasInitial)
+	case "concat":
+		result, err = concat(list, initial, hasInitial)
+	case "merge":
+		result, err = merge(list, initial, hasInitial)
+	case "":
+		err = fmt.Errorf("reducer or expression is required")
+	default:
+		err = fmt.Errorf("unknown reducer %q", reducer)
+	}
+	if err != nil {
+		return map[string]interface{}{"result": nil, "error": err.Error()}
+	}
+	return map[string]interface{}{"result": result}
+}
+
+func arithmetic(reducer string, list []interface{}, initial interface{}, hasInitial bool) (interface{}, error) {
+	acc := 0.0
+	if reducer == "product" {
+		acc = 1
+	}
+	if hasInitial {
+		n, ok := toFloat(initial)
+		if !ok {
+			return nil, fmt.Errorf("initial must be a number for %s", reducer)
+		}
+		acc = n
+	}
+	for i, item := range list {
+		n, ok := toFloat(item)
+		if !ok {
+			return nil, fmt.Errorf("element %d is not a number", i)
+		}
+		if reducer == "sum" {
+			acc += n
+		} else {
+			acc *= n
+		}
+	}
+	return acc, nil
+}
+
+func extreme(reducer string, list []interface{}, initial interface{}, hasInitial bool) (interface{}, error) {
+	values := list
+	if hasInitial {
+		values = append([]interface{}{initial}, list...)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	best := values[0]
+	for i, v := range values[1:] {
+		cmp, ok := compare(v, best)
+		if !ok {
+			return nil, fmt.Errorf("element %d cannot be compared with %v", i+1, best)
+		}
+		if (reducer == "min" && cmp < 0) || (reducer == "max" && cmp > 0) {
+			best = v
+		}
+	}
+	if n, ok := toFloat(best); ok {
+		return n, nil
+	}
+	return best, nil
+}
+
+func concat(list []interface{}, initial interface{}, hasInitial bool) (interface{}, error) {
+	// The accumulator type follows initial, or the first element
+	sample := initial
+	if !hasInitial {
+		if len(list) == 0 {
+			return []interface{}{}, nil
+		}
+		sample = list[0]
+	}
+
+	switch s := sample.(type) {
+	case string:
+		var b strings.Builder
+		if hasInitial {
+			b.WriteString(s)
+		}
+		for i, item := range list {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("element %d is not a string", i)
+			}
+			b.WriteString(str)
+		}
+		return b.String(), nil
+	case []interface{}:
+		result := []interface{}{}
+		if hasInitial {
+			result = append(result, s...)
+		}
+		for i, item := range list {
+			l, ok := item.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("element %d is not a list", i)
+			}
+			result = append(result, l...)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("concat needs strings or lists")
+	}
+}
+
+func merge(list []interface{}, initial interface{}, hasInitial bool) (interface{}, error) {
+	result := map[string]interface{}{}
+	if hasInitial {
+		m, ok := initial.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("initial must be a dictionary for merge")
+		}
+		for k, v := range m {
+			result[k] = v
+		}
+	}
+	for i, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("element %d is not a dictionary", i)
+		}
+		for k, v := range m {
+			result[k] = v
+		}
+	}
+	return result, nil
+}
+
+func storeOf(runtime interface{}) map[string]interface{} {
+	if r, ok := runtime.(Runtime); ok {
+		return r.GetStore()
+	}
+	if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			return s
+		}
+	}
+	return nil
+}
+
+// compare orders two numbers or two strings.
+func compare(a, b interface{}) (int, bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	sa, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	sb, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(sa, sb), true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,7 @@
+// Package list_remove_at provides factory for ListRemoveAt plugin.
+package list_remove_at
+
+// Create returns a new ListRemoveAt instance.
+func Create() *ListRemoveAt {
+	return NewListRemoveAt()
+}
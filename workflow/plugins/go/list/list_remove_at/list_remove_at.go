@@ -0,0 +1,92 @@
+// Package list_remove_at provides a workflow plugin for removing list elements.
+package list_remove_at
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ListRemoveAt implements the NodeExecutor interface for removing list elements.
+type ListRemoveAt struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListRemoveAt creates a new ListRemoveAt instance.
+func NewListRemoveAt() *ListRemoveAt {
+	return &ListRemoveAt{
+		NodeType:    "list.remove_at",
+		Category:    "list",
+		Description: "Remove list elements by index or value",
+	}
+}
+
+// Execute runs the plugin logic.
+// Removes the element at index, or the first element deeply equal to value
+// (every such element with all). Negative indices count from the end. The
+// input list is not modified.
+// Inputs:
+//   - list: the list to remove from
+//   - index: (optional) the position of the element to remove
+//   - value: (optional) the element to remove, when index is not given
+//   - all: (optional) remove every element equal to value (default: false)
+//
+// Returns:
+//   - result: the list without the removed elements
+//   - removed: number of elements removed
+//   - error: set when the index is out of range or neither index nor value is given
+func (p *ListRemoveAt) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+
+	if rawIndex, ok := inputs["index"]; ok {
+		index, ok := toInt(rawIndex)
+		if !ok {
+			return map[string]interface{}{"result": list, "removed": 0, "error": "index must be an integer"}
+		}
+		if index < 0 {
+			index += len(list)
+		}
+		if index < 0 || index >= len(list) {
+			return map[string]interface{}{"result": list, "removed": 0, "error": fmt.Sprintf("index %v out of range for list of length %d", rawIndex, len(list))}
+		}
+		result := make([]interface{}, 0, len(list)-1)
+		result = append(result, list[:index]...)
+		result = append(result, list[index+1:]...)
+		return map[string]interface{}{"result": result, "removed": 1}
+	}
+
+	value, ok := inputs["value"]
+	if !ok {
+		return map[string]interface{}{"result": list, "removed": 0, "error": "index or value is required"}
+	}
+	all, _ := inputs["all"].(bool)
+
+	result := make([]interface{}, 0, len(list))
+	removed := 0
+	for _, item := range list {
+		if (all || removed == 0) && reflect.DeepEqual(item, value) {
+			removed++
+			continue
+		}
+		result = append(result, item)
+	}
+
+	return map[string]interface{}{"result": result, "removed": removed}
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		if n != float64(int(n)) {
+			return 0, false
+		}
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
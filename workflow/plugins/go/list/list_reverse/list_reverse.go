@@ -18,6 +18,11 @@ func NewListReverse() *ListReverse {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - list: the list to reverse
+//
+// Returns:
+//   - result: the reversed list
 func (p *ListReverse) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	list, ok := inputs["list"].([]interface{})
 	if !ok {
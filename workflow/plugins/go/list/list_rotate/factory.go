@@ -0,0 +1,7 @@
+// Package list_rotate provides factory for ListRotate plugin.
+package list_rotate
+
+// Create returns a new ListRotate instance.
+func Create() *ListRotate {
+	return NewListRotate()
+}
@@ -0,0 +1,67 @@
+// Package list_rotate provides a workflow plugin for rotating lists.
+package list_rotate
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/intconv"
+)
+
+// ListRotate implements the NodeExecutor interface for rotating lists.
+type ListRotate struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListRotate creates a new ListRotate instance.
+func NewListRotate() *ListRotate {
+	return &ListRotate{
+		NodeType:    "list.rotate",
+		Category:    "list",
+		Description: "Rotate a list by a number of positions",
+	}
+}
+
+// Execute runs the plugin logic.
+// Rotating [a, b, c, d] left by 1 gives [b, c, d, a], right by 1 gives
+// [d, a, b, c]. A negative count rotates the other way and counts larger
+// than the list wrap around.
+// Inputs:
+//   - list: the list to rotate
+//   - count: (optional) number of positions (default: 1)
+//   - direction: (optional) "left" or "right" (default: "left")
+//
+// Returns:
+//   - result: the rotated list
+//   - error: set if direction is invalid
+func (p *ListRotate) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+
+	right := false
+	switch direction, _ := inputs["direction"].(string); direction {
+	case "", "left":
+	case "right":
+		right = true
+	default:
+		return map[string]interface{}{"result": []interface{}{}, "error": "direction must be \"left\" or \"right\""}
+	}
+
+	result := make([]interface{}, 0, len(list))
+	if len(list) == 0 {
+		return map[string]interface{}{"result": result}
+	}
+	// Reduce the count before converting it, so huge counts stay exact
+	shift, ok := intconv.Mod(inputs["count"], len(list))
+	if !ok {
+		shift = 1 % len(list)
+	}
+	if right {
+		shift = -shift
+	}
+	if shift < 0 {
+		shift += len(list)
+	}
+	result = append(result, list[shift:]...)
+	result = append(result, list[:shift]...)
+
+	return map[string]interface{}{"result": result}
+}
@@ -0,0 +1,7 @@
+// Package list_sample provides factory for ListSample plugin.
+package list_sample
+
+// Create returns a new ListSample instance.
+func Create() *ListSample {
+	return NewListSample()
+}
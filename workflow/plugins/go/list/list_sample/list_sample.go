@@ -0,0 +1,111 @@
+// Package list_sample provides a workflow plugin for sampling lists.
+package list_sample
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ListSample implements the NodeExecutor interface for sampling lists.
+type ListSample struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListSample creates a new ListSample instance.
+func NewListSample() *ListSample {
+	return &ListSample{
+		NodeType:    "list.sample",
+		Category:    "list",
+		Description: "Pick random elements from a list",
+	}
+}
+
+// maxLength bounds the sample when sampling with replacement, where count
+// is not limited by the list.
+const maxLength = 100000
+
+// Execute runs the plugin logic.
+// Picks count random elements. Without replacement each element is picked
+// at most once; with replacement the same element may repeat. The same
+// seed always gives the same sample, for reproducible tests.
+// Inputs:
+//   - list: the list to sample from
+//   - count: (optional) number of elements to pick (default: 1)
+//   - replace: (optional) sample with replacement (default: false)
+//   - seed: (optional) integer seed for the random generator
+//
+// Returns:
+//   - result: the sampled elements
+//   - error: set when count is negative, larger than the list without replacement or above 100000 with replacement
+func (p *ListSample) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+
+	count := 1
+	if raw, ok := inputs["count"]; ok {
+		n, ok := toInt(raw)
+		if !ok || n < 0 {
+			return map[string]interface{}{"result": []interface{}{}, "error": "count must be a non-negative integer"}
+		}
+		count = n
+	}
+	replace, _ := inputs["replace"].(bool)
+	rng := newRand(inputs["seed"])
+
+	if replace {
+		if count > maxLength {
+			return map[string]interface{}{"result": []interface{}{}, "error": fmt.Sprintf("count must be at most %d", maxLength)}
+		}
+		if len(list) == 0 && count > 0 {
+			return map[string]interface{}{"result": []interface{}{}, "error": "cannot sample from an empty list"}
+		}
+		result := make([]interface{}, 0, count)
+		for i := 0; i < count; i++ {
+			result = append(result, list[rng.Intn(len(list))])
+		}
+		return map[string]interface{}{"result": result}
+	}
+
+	if count > len(list) {
+		return map[string]interface{}{"result": []interface{}{}, "error": fmt.Sprintf("cannot sample %d elements from a list of %d without replacement", count, len(list))}
+	}
+	result := make([]interface{}, 0, count)
+	for _, i := range rng.Perm(len(list))[:count] {
+		result = append(result, list[i])
+	}
+	return map[string]interface{}{"result": result}
+}
+
+// newRand returns a generator seeded with seed, or with the current time
+// when seed is not a number.
+func newRand(seed interface{}) *rand.Rand {
+	switch s := seed.(type) {
+	case int:
+		return rand.New(rand.NewSource(int64(s)))
+	case int64:
+		return rand.New(rand.NewSource(s))
+	case float64:
+		return rand.New(rand.NewSource(int64(s)))
+	default:
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		if n != math.Trunc(n) || n < math.MinInt || n >= math.MaxInt {
+			return 0, false
+		}
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
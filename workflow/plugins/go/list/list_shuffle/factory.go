@@ -0,0 +1,7 @@
+// Package list_shuffle provides factory for ListShuffle plugin.
+package list_shuffle
+
+// Create returns a new ListShuffle instance.
+func Create() *ListShuffle {
+	return NewListShuffle()
+}
@@ -0,0 +1,59 @@
+// Package list_shuffle provides a workflow plugin for shuffling lists.
+package list_shuffle
+
+import (
+	"math/rand"
+	"time"
+)
+
+// ListShuffle implements the NodeExecutor interface for shuffling lists.
+type ListShuffle struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListShuffle creates a new ListShuffle instance.
+func NewListShuffle() *ListShuffle {
+	return &ListShuffle{
+		NodeType:    "list.shuffle",
+		Category:    "list",
+		Description: "Randomly reorder a list",
+	}
+}
+
+// Execute runs the plugin logic.
+// Returns the elements in random order; the input list is not modified.
+// The same seed always gives the same order, for reproducible tests.
+// Inputs:
+//   - list: the list to shuffle
+//   - seed: (optional) integer seed for the random generator
+//
+// Returns:
+//   - result: the shuffled list
+func (p *ListShuffle) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	list, _ := inputs["list"].([]interface{})
+
+	result := append([]interface{}{}, list...)
+	rng := newRand(inputs["seed"])
+	rng.Shuffle(len(result), func(i, j int) {
+		result[i], result[j] = result[j], result[i]
+	})
+
+	return map[string]interface{}{"result": result}
+}
+
+// newRand returns a generator seeded with seed, or with the current time
+// when seed is not a number.
+func newRand(seed interface{}) *rand.Rand {
+	switch s := seed.(type) {
+	case int:
+		return rand.New(rand.NewSource(int64(s)))
+	case int64:
+		return rand.New(rand.NewSource(s))
+	case float64:
+		return rand.New(rand.NewSource(int64(s)))
+	default:
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+}
@@ -18,6 +18,13 @@ func NewListSlice() *ListSlice {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - list: the list to slice
+//   - start: (optional) start index, negative counts from the end (default: 0)
+//   - end: (optional) end index (exclusive), negative counts from the end (default: length)
+//
+// Returns:
+//   - result: the extracted portion of the list
 func (p *ListSlice) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	list, ok := inputs["list"].([]interface{})
 	if !ok {
@@ -46,6 +53,9 @@ func (p *ListSlice) Execute(inputs map[string]interface{}, runtime interface{})
 	if start < 0 {
 		start = 0
 	}
+	if end < 0 {
+		end = 0
+	}
 	if end > len(list) {
 		end = len(list)
 	}
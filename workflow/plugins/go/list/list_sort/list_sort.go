@@ -2,7 +2,12 @@
 package list_sort
 
 import (
+	"fmt"
 	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
 )
 
 // ListSort implements the NodeExecutor interface for sorting lists.
@@ -21,75 +26,249 @@ func NewListSort() *ListSort {
 	}
 }
 
+// sortKey is one level of the ordering.
+type sortKey struct {
+	path       paths.Path
+	descending bool
+	nullsFirst bool
+}
+
 // Execute runs the plugin logic.
+// The sort is stable. Numbers sort before strings, strings before booleans
+// (false first) and other values keep their relative order. Null and
+// missing keys are placed by nulls, whatever the direction.
 // Inputs:
 //   - list: the list to sort
-//   - key: (optional) the key to sort by for objects
+//   - key: (optional) the key to sort by for objects (supports dot notation)
+//   - keys: (optional) list of sort keys, most significant first; each is a path or a dictionary with key, descending and nulls
 //   - descending: (optional) sort in descending order (default: false)
+//   - nulls: (optional) "first" or "last" (default: "last")
+//   - collation: (optional) string comparison, "binary", "case_insensitive" or "natural" (case-insensitive, digit runs compared as numbers) (default: "binary")
 //
 // Returns:
 //   - result: the sorted list
+//   - error: set when a key, nulls or collation is invalid
 func (p *ListSort) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	list, ok := inputs["list"].([]interface{})
 	if !ok {
 		return map[string]interface{}{"result": []interface{}{}}
 	}
-
-	// Make a copy to avoid mutating the original
-	result := make([]interface{}, len(list))
-	copy(result, list)
-
-	descending := false
-	if d, ok := inputs["descending"].(bool); ok {
-		descending = d
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": []interface{}{}, "error": fmt.Sprintf(format, args...)}
 	}
 
-	key, hasKey := inputs["key"].(string)
-
-	sort.SliceStable(result, func(i, j int) bool {
-		var a, b interface{}
+	descending, _ := inputs["descending"].(bool)
+	nullsFirst, err := parseNulls(inputs["nulls"])
+	if err != nil {
+		return fail("%v", err)
+	}
+	collation := "binary"
+	if c, ok := inputs["collation"].(string); ok && c != "" {
+		collation = c
+	}
+	var compareStrings func(a, b string) int
+	switch collation {
+	case "binary":
+		compareStrings = strings.Compare
+	case "case_insensitive":
+		compareStrings = compareFold
+	case "natural":
+		compareStrings = compareNatural
+	default:
+		return fail("unknown collation %q", collation)
+	}
 
-		if hasKey {
-			// Extract values by key for objects
-			if objA, ok := result[i].(map[string]interface{}); ok {
-				a = objA[key]
+	var keys []sortKey
+	if raw, ok := inputs["keys"].([]interface{}); ok && len(raw) > 0 {
+		for i, k := range raw {
+			key := sortKey{descending: descending, nullsFirst: nullsFirst}
+			var path string
+			switch k := k.(type) {
+			case string:
+				path = k
+			case map[string]interface{}:
+				path, _ = k["key"].(string)
+				if d, ok := k["descending"].(bool); ok {
+					key.descending = d
+				}
+				if n, ok := k["nulls"]; ok {
+					if key.nullsFirst, err = parseNulls(n); err != nil {
+						return fail("keys[%d]: %v", i, err)
+					}
+				}
+			default:
+				return fail("keys[%d] must be a path or a dictionary", i)
+			}
+			if key.path, err = paths.Parse(path); err != nil {
+				return fail("keys[%d]: %v", i, err)
 			}
-			if objB, ok := result[j].(map[string]interface{}); ok {
-				b = objB[key]
+			keys = append(keys, key)
+		}
+	} else {
+		key := sortKey{descending: descending, nullsFirst: nullsFirst}
+		if k, ok := inputs["key"].(string); ok {
+			if key.path, err = paths.Parse(k); err != nil {
+				return fail("%v", err)
 			}
-		} else {
-			a = result[i]
-			b = result[j]
 		}
+		keys = []sortKey{key}
+	}
+
+	// Extract the sort values once rather than on every comparison
+	values := make([][]interface{}, len(list))
+	for i, item := range list {
+		values[i] = make([]interface{}, len(keys))
+		for k, key := range keys {
+			values[i][k], _ = paths.Get(item, key.path)
+		}
+	}
+	order := make([]int, len(list))
+	for i := range order {
+		order[i] = i
+	}
 
-		less := compareLess(a, b)
-		if descending {
-			return !less
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := values[order[i]], values[order[j]]
+		for k, key := range keys {
+			aNull, bNull := a[k] == nil, b[k] == nil
+			if aNull || bNull {
+				if aNull == bNull {
+					continue
+				}
+				return aNull == key.nullsFirst
+			}
+			c := compare(a[k], b[k], compareStrings)
+			if c == 0 {
+				continue
+			}
+			if key.descending {
+				return c > 0
+			}
+			return c < 0
 		}
-		return less
+		return false
 	})
 
+	result := make([]interface{}, len(list))
+	for i, idx := range order {
+		result[i] = list[idx]
+	}
+
 	return map[string]interface{}{"result": result}
 }
 
-// compareLess compares two values and returns true if a < b.
-func compareLess(a, b interface{}) bool {
-	// Handle numeric comparisons
-	aNum, aIsNum := toFloat64(a)
-	bNum, bIsNum := toFloat64(b)
-	if aIsNum && bIsNum {
-		return aNum < bNum
+func parseNulls(v interface{}) (bool, error) {
+	switch v {
+	case nil, "", "last":
+		return false, nil
+	case "first":
+		return true, nil
 	}
+	return false, fmt.Errorf("nulls must be \"first\" or \"last\"")
+}
+
+// rank orders values of different kinds: numbers, strings, booleans, other.
+func rank(v interface{}) int {
+	if _, ok := toFloat64(v); ok {
+		return 0
+	}
+	switch v.(type) {
+	case string:
+		return 1
+	case bool:
+		return 2
+	}
+	return 3
+}
 
-	// Handle string comparisons
-	aStr, aIsStr := a.(string)
-	bStr, bIsStr := b.(string)
-	if aIsStr && bIsStr {
-		return aStr < bStr
+// compare returns -1, 0 or 1 as a sorts before, with or after b.
+func compare(a, b interface{}, compareStrings func(a, b string) int) int {
+	ra, rb := rank(a), rank(b)
+	if ra != rb {
+		if ra < rb {
+			return -1
+		}
+		return 1
 	}
+	switch ra {
+	case 0:
+		x, _ := toFloat64(a)
+		y, _ := toFloat64(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	case 1:
+		return compareStrings(a.(string), b.(string))
+	case 2:
+		x, y := a.(bool), b.(bool)
+		if x != y {
+			if y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
 
-	// Default: keep original order
-	return false
+// compareFold compares strings ignoring case, falling back to a binary
+// comparison so that the order stays total.
+func compareFold(a, b string) int {
+	if c := strings.Compare(strings.ToLower(a), strings.ToLower(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// compareNatural compares strings ignoring case, with runs of digits
+// compared by numeric value, so "file2" sorts before "file10".
+func compareNatural(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		if unicode.IsDigit(x[i]) && unicode.IsDigit(y[j]) {
+			si, sj := i, j
+			for i < len(x) && unicode.IsDigit(x[i]) {
+				i++
+			}
+			for j < len(y) && unicode.IsDigit(y[j]) {
+				j++
+			}
+			// Compare digit runs without leading zeros by length, then
+			// digit by digit, so any length of number is handled
+			na := strings.TrimLeft(string(x[si:i]), "0")
+			nb := strings.TrimLeft(string(y[sj:j]), "0")
+			if len(na) != len(nb) {
+				if len(na) < len(nb) {
+					return -1
+				}
+				return 1
+			}
+			if c := strings.Compare(na, nb); c != 0 {
+				return c
+			}
+			continue
+		}
+		ca, cb := unicode.ToLower(x[i]), unicode.ToLower(y[j])
+		if ca != cb {
+			if ca < cb {
+				return -1
+			}
+			return 1
+		}
+		i++
+		j++
+	}
+	switch {
+	case len(x)-i < len(y)-j:
+		return -1
+	case len(x)-i > len(y)-j:
+		return 1
+	}
+	return compareFold(a, b)
 }
 
 // toFloat64 converts various numeric types to float64.
@@ -0,0 +1,7 @@
+// Package list_take_while provides factory for ListTakeWhile plugin.
+package list_take_while
+
+// Create returns a new ListTakeWhile instance.
+func Create() *ListTakeWhile {
+	return NewListTakeWhile()
+}
@@ -0,0 +1,98 @@
+// Package list_take_while provides a workflow plugin for taking list elements while a condition holds.
+package list_take_while
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+	"github.com/metabuilder/workflow-plugins-go/predicate"
+)
+
+// ListTakeWhile implements the NodeExecutor interface for taking list elements while a condition holds.
+type ListTakeWhile struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListTakeWhile creates a new ListTakeWhile instance.
+func NewListTakeWhile() *ListTakeWhile {
+	return &ListTakeWhile{
+		NodeType:    "list.take_while",
+		Category:    "list",
+		Description: "Take elements from the front of a list while a condition holds",
+	}
+}
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// Execute runs the plugin logic.
+// Returns the leading elements that match the condition, up to (not
+// including) the first element that does not.
+// The condition is given like in list.find_all: key, op and value, or an
+// expression. Elements without the key do not match.
+// Inputs:
+//   - list: the list to process
+//   - key: (optional) path of the field to test in object elements (supports dot notation)
+//   - op: (optional) eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists
+//   - value: (optional) the value to compare with
+//   - expression: (optional) condition evaluated per element instead of key/op/value
+//
+// Returns:
+//   - result: the leading matching elements
+//   - index: index of the first element that does not match, or the list length
+//   - error: set when key, op, value or expression is invalid
+func (p *ListTakeWhile) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(err error) map[string]interface{} {
+		return map[string]interface{}{"result": []interface{}{}, "index": 0, "error": err.Error()}
+	}
+	list, _ := inputs["list"].([]interface{})
+
+	var match predicate.Func
+	var err error
+	if expression, ok := inputs["expression"].(string); ok && expression != "" {
+		match, err = predicate.Expression(expression, storeOf(runtime))
+	} else {
+		var path paths.Path
+		if key, ok := inputs["key"].(string); ok {
+			if path, err = paths.Parse(key); err != nil {
+				return fail(err)
+			}
+		}
+		op, _ := inputs["op"].(string)
+		match, err = predicate.Field(path, op, inputs["value"])
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	index := len(list)
+	for i, item := range list {
+		ok, err := match(i, item)
+		if err != nil {
+			return fail(fmt.Errorf("element %d: %v", i, err))
+		}
+		if !ok {
+			index = i
+			break
+		}
+	}
+
+	result := append([]interface{}{}, list[:index]...)
+	return map[string]interface{}{"result": result, "index": index}
+}
+
+func storeOf(runtime interface{}) map[string]interface{} {
+	if r, ok := runtime.(Runtime); ok {
+		return r.GetStore()
+	}
+	if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			return s
+		}
+	}
+	return nil
+}
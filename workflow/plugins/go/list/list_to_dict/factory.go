@@ -0,0 +1,7 @@
+// Package list_to_dict provides factory for ListToDict plugin.
+package list_to_dict
+
+// Create returns a new ListToDict instance.
+func Create() *ListToDict {
+	return NewListToDict()
+}
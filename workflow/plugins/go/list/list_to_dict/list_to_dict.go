@@ -0,0 +1,172 @@
+// Package list_to_dict provides a workflow plugin for indexing a list by a field.
+package list_to_dict
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/metabuilder/workflow-plugins-go/expr"
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// ListToDict implements the NodeExecutor interface for indexing a list by a field.
+type ListToDict struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListToDict creates a new ListToDict instance.
+func NewListToDict() *ListToDict {
+	return &ListToDict{
+		NodeType:    "list.to_dict",
+		Category:    "list",
+		Description: "Index a list of objects by a field",
+	}
+}
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// Execute runs the plugin logic.
+// Builds a lookup dictionary from a list, e.g. users keyed by id before
+// joining them with orders. The key of each element is the value at key or
+// the result of expression (evaluated with item and index defined, plus
+// the element's fields when it is an object), formatted the way
+// convert.to_string does: strings as is, everything else JSON encoded.
+// Elements without a key (or with a null one) are skipped.
+// Inputs:
+//   - list: the list of objects to index
+//   - key: (optional) path of the field to index by (supports dot notation)
+//   - expression: (optional) expression computing the key instead of key
+//   - on_collision: (optional) what to do when several elements share a key: "last" (default) keeps the last element, "first" keeps the first, "collect" maps every key to a list of elements, "error" fails
+//
+// Returns:
+//   - result: the dictionary of key to element
+//   - collisions: keys that more than one element had, sorted
+//   - skipped: number of elements without the key
+//   - error: set when key, expression or on_collision is invalid, or on a collision with "error"
+func (p *ListToDict) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": map[string]interface{}{}, "collisions": []interface{}{}, "skipped": 0, "error": fmt.Sprintf(format, args...)}
+	}
+	list, _ := inputs["list"].([]interface{})
+
+	strategy, _ := inputs["on_collision"].(string)
+	switch strategy {
+	case "":
+		strategy = "last"
+	case "first", "last", "collect", "error":
+	default:
+		return fail("unknown on_collision %q", strategy)
+	}
+
+	var keyOf func(i int, item interface{}) (interface{}, bool, error)
+	if expression, ok := inputs["expression"].(string); ok && expression != "" {
+		x, err := expr.Parse(expression)
+		if err != nil {
+			return fail("%v", err)
+		}
+		store := storeOf(runtime)
+		keyOf = func(i int, item interface{}) (interface{}, bool, error) {
+			vars := map[string]interface{}{}
+			if obj, ok := item.(map[string]interface{}); ok {
+				for k, v := range obj {
+					vars[k] = v
+				}
+			}
+			vars["item"], vars["index"] = item, float64(i)
+			v, err := x.Eval(vars, store)
+			return v, v != nil, err
+		}
+	} else {
+		key, _ := inputs["key"].(string)
+		if key == "" {
+			return fail("key or expression is required")
+		}
+		path, err := paths.Parse(key)
+		if err != nil {
+			return fail("%v", err)
+		}
+		keyOf = func(_ int, item interface{}) (interface{}, bool, error) {
+			v, found := paths.Get(item, path)
+			return v, found && v != nil, nil
+		}
+	}
+
+	result := make(map[string]interface{}, len(list))
+	counts := make(map[string]int, len(list))
+	skipped := 0
+	for i, item := range list {
+		v, found, err := keyOf(i, item)
+		if err != nil {
+			return fail("element %d: %v", i, err)
+		}
+		if !found {
+			skipped++
+			continue
+		}
+		k := stringify(v)
+		counts[k]++
+		switch strategy {
+		case "first":
+			if _, exists := result[k]; !exists {
+				result[k] = item
+			}
+		case "last":
+			result[k] = item
+		case "collect":
+			items, _ := result[k].([]interface{})
+			result[k] = append(items, item)
+		case "error":
+			if counts[k] > 1 {
+				return fail("duplicate key %q at element %d", k, i)
+			}
+			result[k] = item
+		}
+	}
+
+	collisions := []string{}
+	for k, n := range counts {
+		if n > 1 {
+			collisions = append(collisions, k)
+		}
+	}
+	sort.Strings(collisions)
+	keys := make([]interface{}, len(collisions))
+	for i, k := range collisions {
+		keys[i] = k
+	}
+
+	return map[string]interface{}{"result": result, "collisions": keys, "skipped": skipped}
+}
+
+// stringify formats a value as a dictionary key, matching convert.to_string.
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		if bytes, err := json.Marshal(v); err == nil {
+			return string(bytes)
+		}
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func storeOf(runtime interface{}) map[string]interface{} {
+	if r, ok := runtime.(Runtime); ok {
+		return r.GetStore()
+	}
+	if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			return s
+		}
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+// Package list_union provides factory for ListUnion plugin.
+package list_union
+
+// Create returns a new ListUnion instance.
+func Create() *ListUnion {
+	return NewListUnion()
+}
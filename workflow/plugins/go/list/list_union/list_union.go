@@ -0,0 +1,78 @@
+// Package list_union provides a workflow plugin for joining lists as sets.
+package list_union
+
+import (
+	"fmt"
+
+	"github.com/metabuilder/workflow-plugins-go/hashing"
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// ListUnion implements the NodeExecutor interface for joining lists as sets.
+type ListUnion struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewListUnion creates a new ListUnion instance.
+func NewListUnion() *ListUnion {
+	return &ListUnion{
+		NodeType:    "list.union",
+		Category:    "list",
+		Description: "Distinct elements of all lists",
+	}
+}
+
+// Execute runs the plugin logic.
+// Returns every distinct element of the lists, in order of first occurrence:
+// the first list's elements, then new elements of the second, and so on.
+// Inputs:
+//   - lists: the lists to combine
+//   - key: (optional) path of the field to compare object elements by instead of as a whole, like list.unique
+//
+// Returns:
+//   - result: distinct elements of all lists
+//   - error: set if lists is not a list of lists or key is not a valid path
+func (p *ListUnion) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	lists, errMsg := listsOf(inputs["lists"])
+	if errMsg != "" {
+		return map[string]interface{}{"result": []interface{}{}, "error": errMsg}
+	}
+	var keys []paths.Path
+	if k, ok := inputs["key"].(string); ok {
+		key, err := paths.Parse(k)
+		if err != nil {
+			return map[string]interface{}{"result": []interface{}{}, "error": err.Error()}
+		}
+		keys = append(keys, key)
+	}
+
+	result := []interface{}{}
+	seen := make(map[string]bool)
+	for _, list := range lists {
+		for _, item := range list {
+			id := hashing.Identity(item, keys...)
+			if !seen[id] {
+				seen[id] = true
+				result = append(result, item)
+			}
+		}
+	}
+
+	return map[string]interface{}{"result": result}
+}
+
+func listsOf(v interface{}) ([][]interface{}, string) {
+	raw, ok := v.([]interface{})
+	if !ok {
+		return nil, "lists must be an array of arrays"
+	}
+	lists := make([][]interface{}, len(raw))
+	for i, l := range raw {
+		if lists[i], ok = l.([]interface{}); !ok {
+			return nil, fmt.Sprintf("lists[%d] must be an array", i)
+		}
+	}
+	return lists, ""
+}
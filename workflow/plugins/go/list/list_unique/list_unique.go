@@ -2,7 +2,8 @@
 package list_unique
 
 import (
-	"encoding/json"
+	"github.com/metabuilder/workflow-plugins-go/hashing"
+	"github.com/metabuilder/workflow-plugins-go/paths"
 )
 
 // ListUnique implements the NodeExecutor interface for removing duplicates from lists.
@@ -22,40 +23,45 @@ func NewListUnique() *ListUnique {
 }
 
 // Execute runs the plugin logic.
+// Elements are equal when they are equal as JSON, so 1 and 1.0 are
+// duplicates while 1 and "1" are not. The first occurrence is kept.
 // Inputs:
 //   - list: the list to deduplicate
-//   - key: (optional) the key to use for uniqueness in objects
+//   - key: (optional) path of the field to use for uniqueness in objects (supports dot notation)
+//   - keys: (optional) list of paths that together identify objects, instead of key
 //
 // Returns:
 //   - result: the list with duplicates removed
+//   - error: set when a key is not a valid path
 func (p *ListUnique) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	list, ok := inputs["list"].([]interface{})
 	if !ok {
 		return map[string]interface{}{"result": []interface{}{}}
 	}
 
-	key, hasKey := inputs["key"].(string)
+	var names []string
+	if raw, ok := inputs["keys"].([]interface{}); ok {
+		for _, k := range raw {
+			if s, ok := k.(string); ok {
+				names = append(names, s)
+			}
+		}
+	} else if key, ok := inputs["key"].(string); ok {
+		names = []string{key}
+	}
+	keys := make([]paths.Path, len(names))
+	for i, name := range names {
+		var err error
+		if keys[i], err = paths.Parse(name); err != nil {
+			return map[string]interface{}{"result": []interface{}{}, "error": err.Error()}
+		}
+	}
+
 	seen := make(map[string]bool)
 	result := make([]interface{}, 0, len(list))
 
 	for _, item := range list {
-		var identifier string
-
-		if hasKey {
-			// Use specific key for uniqueness in objects
-			if obj, ok := item.(map[string]interface{}); ok {
-				if val, exists := obj[key]; exists {
-					identifier = toHashKey(val)
-				} else {
-					identifier = toHashKey(item)
-				}
-			} else {
-				identifier = toHashKey(item)
-			}
-		} else {
-			identifier = toHashKey(item)
-		}
-
+		identifier := hashing.Identity(item, keys...)
 		if !seen[identifier] {
 			seen[identifier] = true
 			result = append(result, item)
@@ -64,29 +70,3 @@ func (p *ListUnique) Execute(inputs map[string]interface{}, runtime interface{})
 
 	return map[string]interface{}{"result": result}
 }
-
-// toHashKey converts a value to a string suitable for use as a map key.
-func toHashKey(v interface{}) string {
-	switch val := v.(type) {
-	case string:
-		return "s:" + val
-	case float64:
-		return "n:" + json.Number(json.Number(string(rune(val)))).String()
-	case int:
-		return "i:" + string(rune(val))
-	case bool:
-		if val {
-			return "b:true"
-		}
-		return "b:false"
-	case nil:
-		return "null"
-	default:
-		// For complex types, use JSON encoding
-		bytes, err := json.Marshal(val)
-		if err != nil {
-			return "unknown"
-		}
-		return "j:" + string(bytes)
-	}
-}
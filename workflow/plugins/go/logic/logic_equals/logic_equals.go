@@ -22,6 +22,12 @@ func NewLogicEquals() *LogicEquals {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - a: the first value
+//   - b: the second value
+//
+// Returns:
+//   - result: whether the values are deeply equal
 func (p *LogicEquals) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	a := inputs["a"]
 	b := inputs["b"]
@@ -18,6 +18,12 @@ func NewLogicGt() *LogicGt {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - a: the first number
+//   - b: the second number
+//
+// Returns:
+//   - result: whether a is greater than b
 func (p *LogicGt) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	a := toFloat(inputs["a"])
 	b := toFloat(inputs["b"])
@@ -18,6 +18,12 @@ func NewLogicLt() *LogicLt {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - a: the first number
+//   - b: the second number
+//
+// Returns:
+//   - result: whether a is less than b
 func (p *LogicLt) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	a := toFloat(inputs["a"])
 	b := toFloat(inputs["b"])
@@ -18,6 +18,11 @@ func NewLogicNot() *LogicNot {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - value: the value to negate
+//
+// Returns:
+//   - result: true if the value is falsy
 func (p *LogicNot) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	value := inputs["value"]
 	return map[string]interface{}{"result": !toBool(value)}
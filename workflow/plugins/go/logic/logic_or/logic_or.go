@@ -18,6 +18,11 @@ func NewLogicOr() *LogicOr {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - values: list of values to combine
+//
+// Returns:
+//   - result: true if any value is truthy
 func (p *LogicOr) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	values, ok := inputs["values"].([]interface{})
 	if !ok || len(values) == 0 {
@@ -0,0 +1,7 @@
+// Package math_abs provides factory for MathAbs plugin.
+package math_abs
+
+// Create returns a new MathAbs instance.
+func Create() *MathAbs {
+	return NewMathAbs()
+}
@@ -0,0 +1,60 @@
+// Package math_abs provides a workflow plugin for absolute value and sign.
+package math_abs
+
+import (
+	"math"
+)
+
+// MathAbs implements the NodeExecutor interface for absolute value and sign.
+type MathAbs struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewMathAbs creates a new MathAbs instance.
+func NewMathAbs() *MathAbs {
+	return &MathAbs{
+		NodeType:    "math.abs",
+		Category:    "math",
+		Description: "Absolute value and sign of a number",
+	}
+}
+
+// Execute runs the plugin logic.
+// Inputs:
+//   - value: the number
+//
+// Returns:
+//   - result: the absolute value
+//   - sign: -1, 0 or 1
+//   - error: set if value is missing or not a number
+func (p *MathAbs) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	value, ok := toFloat64(inputs["value"])
+	if !ok || math.IsNaN(value) {
+		return map[string]interface{}{"result": 0, "sign": 0, "error": "value must be a number"}
+	}
+
+	sign := 0
+	switch {
+	case value > 0:
+		sign = 1
+	case value < 0:
+		sign = -1
+	}
+
+	return map[string]interface{}{"result": math.Abs(value), "sign": sign}
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
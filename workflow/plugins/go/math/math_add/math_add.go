@@ -1,6 +1,13 @@
 // Package math_add provides a workflow plugin for adding numbers.
 package math_add
 
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/metabuilder/workflow-plugins-go/decimal"
+)
+
 // MathAdd implements the NodeExecutor interface for adding numbers.
 type MathAdd struct {
 	NodeType    string
@@ -18,12 +25,27 @@ func NewMathAdd() *MathAdd {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - numbers: list of numbers to add
+//   - precision: (optional) "float", or "decimal" for exact decimal arithmetic on numbers and numeric strings, with the result as a string (default: "float")
+//
+// Returns:
+//   - result: the sum
+//   - error: set if numbers is not a list, or in decimal precision an element is not a number or a result needs more than 4000 digits
 func (p *MathAdd) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	numbers, ok := inputs["numbers"].([]interface{})
 	if !ok {
 		return map[string]interface{}{"result": 0, "error": "numbers must be an array"}
 	}
 
+	switch precision, _ := inputs["precision"].(string); precision {
+	case "", "float":
+	case "decimal":
+		return addDecimal(numbers)
+	default:
+		return map[string]interface{}{"result": 0, "error": fmt.Sprintf("unknown precision %q (want float or decimal)", precision)}
+	}
+
 	var sum float64
 	for _, n := range numbers {
 		switch v := n.(type) {
@@ -38,3 +60,19 @@ func (p *MathAdd) Execute(inputs map[string]interface{}, runtime interface{}) ma
 
 	return map[string]interface{}{"result": sum}
 }
+
+// addDecimal computes the sum exactly.
+func addDecimal(numbers []interface{}) map[string]interface{} {
+	sum := new(big.Rat)
+	for i, n := range numbers {
+		r, err := decimal.Parse(n)
+		if err != nil {
+			return map[string]interface{}{"result": 0, "error": fmt.Sprintf("numbers[%d]: %v", i, err)}
+		}
+		if err := decimal.Check(sum.Add(sum, r)); err != nil {
+			return map[string]interface{}{"result": 0, "error": fmt.Sprintf("numbers[%d]: %v", i, err)}
+		}
+	}
+
+	return map[string]interface{}{"result": decimal.String(sum, decimal.DefaultScale)}
+}
@@ -3,6 +3,10 @@ package math_divide
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/metabuilder/workflow-plugins-go/decimal"
 )
 
 // MathDivide implements the NodeExecutor interface for dividing numbers.
@@ -22,12 +26,28 @@ func NewMathDivide() *MathDivide {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - numbers: list of numbers, the first is divided by each subsequent number
+//   - precision: (optional) "float", or "decimal" for exact decimal arithmetic on numbers and numeric strings, with the result as a string (default: "float")
+//   - scale: (optional) in decimal precision, decimal places kept when the quotient does not terminate, such as 1 / 3 (default: 20)
+//
+// Returns:
+//   - result: the quotient
+//   - error: set on division by zero, if fewer than two numbers are given or, in decimal precision, if an element is not a number or a result needs more than 4000 digits
 func (p *MathDivide) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	numbers, ok := inputs["numbers"].([]interface{})
 	if !ok || len(numbers) < 2 {
 		return map[string]interface{}{"result": 0, "error": "numbers must have at least 2 elements"}
 	}
 
+	switch precision, _ := inputs["precision"].(string); precision {
+	case "", "float":
+	case "decimal":
+		return divideDecimal(numbers, inputs["scale"])
+	default:
+		return map[string]interface{}{"result": 0, "error": fmt.Sprintf("unknown precision %q (want float or decimal)", precision)}
+	}
+
 	result := toFloat64(numbers[0])
 	for i := 1; i < len(numbers); i++ {
 		divisor := toFloat64(numbers[i])
@@ -40,6 +60,41 @@ func (p *MathDivide) Execute(inputs map[string]interface{}, runtime interface{})
 	return map[string]interface{}{"result": result}
 }
 
+// divideDecimal computes the quotient exactly, rounding it half to even to
+// scale places only when it has no exact decimal form.
+func divideDecimal(numbers []interface{}, scaleInput interface{}) map[string]interface{} {
+	scale := decimal.DefaultScale
+	switch n := scaleInput.(type) {
+	case float64:
+		scale = int(n)
+	case int:
+		scale = n
+	}
+	if scale < 0 || scale > 1000 {
+		return map[string]interface{}{"result": 0, "error": "scale must be between 0 and 1000"}
+	}
+
+	result := new(big.Rat)
+	for i, n := range numbers {
+		r, err := decimal.Parse(n)
+		if err != nil {
+			return map[string]interface{}{"result": 0, "error": fmt.Sprintf("numbers[%d]: %v", i, err)}
+		}
+		if i == 0 {
+			result.Set(r)
+			continue
+		}
+		if r.Sign() == 0 {
+			return map[string]interface{}{"result": 0, "error": "division by zero"}
+		}
+		if err := decimal.Check(result.Quo(result, r)); err != nil {
+			return map[string]interface{}{"result": 0, "error": fmt.Sprintf("numbers[%d]: %v", i, err)}
+		}
+	}
+
+	return map[string]interface{}{"result": decimal.String(result, scale)}
+}
+
 func toFloat64(v interface{}) float64 {
 	switch n := v.(type) {
 	case float64:
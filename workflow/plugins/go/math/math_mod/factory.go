@@ -0,0 +1,7 @@
+// Package math_mod provides factory for MathMod plugin.
+package math_mod
+
+// Create returns a new MathMod instance.
+func Create() *MathMod {
+	return NewMathMod()
+}
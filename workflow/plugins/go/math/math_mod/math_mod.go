@@ -0,0 +1,90 @@
+// Package math_mod provides a workflow plugin for integer division and remainders.
+package math_mod
+
+import (
+	"fmt"
+	"math"
+)
+
+// MathMod implements the NodeExecutor interface for quotient and remainder.
+type MathMod struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewMathMod creates a new MathMod instance.
+func NewMathMod() *MathMod {
+	return &MathMod{
+		NodeType:    "math.mod",
+		Category:    "math",
+		Description: "Integer division with quotient and remainder",
+	}
+}
+
+// Execute runs the plugin logic.
+// The modes differ for negative numbers. "floored" (as in Python) rounds
+// the quotient down, so the remainder takes the sign of the divisor:
+// -7 mod 3 is 2, which suits bucketing and wrapping around. "truncated"
+// (as in Go and JavaScript) rounds the quotient toward zero, so the
+// remainder takes the sign of the dividend: -7 mod 3 is -1. Either way
+// dividend = quotient * divisor + remainder. Fractional inputs are allowed.
+// Inputs:
+//   - dividend: the number to divide
+//   - divisor: the number to divide by
+//   - mode: (optional) "floored" or "truncated" (default: "floored")
+//
+// Returns:
+//   - quotient: the whole number of times divisor goes into dividend
+//   - remainder: what is left over
+//   - error: set if an input is missing, divisor is zero, mode is unknown or the quotient overflows
+func (p *MathMod) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(msg string) map[string]interface{} {
+		return map[string]interface{}{"quotient": 0, "remainder": 0, "error": msg}
+	}
+	dividend, ok := toFloat64(inputs["dividend"])
+	if !ok {
+		return fail("dividend must be a number")
+	}
+	divisor, ok := toFloat64(inputs["divisor"])
+	if !ok {
+		return fail("divisor must be a number")
+	}
+	if divisor == 0 {
+		return fail("division by zero")
+	}
+
+	remainder := math.Mod(dividend, divisor)
+	switch mode, _ := inputs["mode"].(string); mode {
+	case "", "floored":
+		if remainder != 0 && (remainder < 0) != (divisor < 0) {
+			remainder += divisor
+		}
+	case "truncated":
+	default:
+		return fail(fmt.Sprintf("unknown mode %q (want floored or truncated)", mode))
+	}
+	if remainder == 0 {
+		// math.Mod keeps the sign of the dividend, even for zero
+		remainder = 0
+	}
+	quotient := math.Round((dividend - remainder) / divisor)
+	if math.IsInf(quotient, 0) || math.IsNaN(quotient) {
+		return fail("quotient overflows")
+	}
+
+	return map[string]interface{}{"quotient": quotient, "remainder": remainder}
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
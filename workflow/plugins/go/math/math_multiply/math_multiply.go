@@ -1,6 +1,13 @@
 // Package math_multiply provides a workflow plugin for multiplying numbers.
 package math_multiply
 
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/metabuilder/workflow-plugins-go/decimal"
+)
+
 // MathMultiply implements the NodeExecutor interface for multiplying numbers.
 type MathMultiply struct {
 	NodeType    string
@@ -18,12 +25,27 @@ func NewMathMultiply() *MathMultiply {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - numbers: list of numbers to multiply
+//   - precision: (optional) "float", or "decimal" for exact decimal arithmetic on numbers and numeric strings, with the result as a string (default: "float")
+//
+// Returns:
+//   - result: the product
+//   - error: set if numbers is not a non-empty list, or in decimal precision an element is not a number or a result needs more than 4000 digits
 func (p *MathMultiply) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	numbers, ok := inputs["numbers"].([]interface{})
 	if !ok || len(numbers) == 0 {
 		return map[string]interface{}{"result": 0, "error": "numbers must be a non-empty array"}
 	}
 
+	switch precision, _ := inputs["precision"].(string); precision {
+	case "", "float":
+	case "decimal":
+		return multiplyDecimal(numbers)
+	default:
+		return map[string]interface{}{"result": 0, "error": fmt.Sprintf("unknown precision %q (want float or decimal)", precision)}
+	}
+
 	result := 1.0
 	for _, n := range numbers {
 		result *= toFloat64(n)
@@ -32,6 +54,22 @@ func (p *MathMultiply) Execute(inputs map[string]interface{}, runtime interface{
 	return map[string]interface{}{"result": result}
 }
 
+// multiplyDecimal computes the product exactly.
+func multiplyDecimal(numbers []interface{}) map[string]interface{} {
+	result := new(big.Rat).SetInt64(1)
+	for i, n := range numbers {
+		r, err := decimal.Parse(n)
+		if err != nil {
+			return map[string]interface{}{"result": 0, "error": fmt.Sprintf("numbers[%d]: %v", i, err)}
+		}
+		if err := decimal.Check(result.Mul(result, r)); err != nil {
+			return map[string]interface{}{"result": 0, "error": fmt.Sprintf("numbers[%d]: %v", i, err)}
+		}
+	}
+
+	return map[string]interface{}{"result": decimal.String(result, decimal.DefaultScale)}
+}
+
 func toFloat64(v interface{}) float64 {
 	switch n := v.(type) {
 	case float64:
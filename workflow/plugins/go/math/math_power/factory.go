@@ -0,0 +1,7 @@
+// Package math_power provides factory for MathPower plugin.
+package math_power
+
+// Create returns a new MathPower instance.
+func Create() *MathPower {
+	return NewMathPower()
+}
@@ -0,0 +1,68 @@
+// Package math_power provides a workflow plugin for exponentiation.
+package math_power
+
+import (
+	"math"
+)
+
+// MathPower implements the NodeExecutor interface for raising numbers to a power.
+type MathPower struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewMathPower creates a new MathPower instance.
+func NewMathPower() *MathPower {
+	return &MathPower{
+		NodeType:    "math.power",
+		Category:    "math",
+		Description: "Raise a number to a power",
+	}
+}
+
+// Execute runs the plugin logic.
+// Inputs:
+//   - base: the number to raise
+//   - exponent: the power to raise it to
+//
+// Returns:
+//   - result: base raised to exponent
+//   - error: set if an input is missing, the result is not a real number (a negative base with a fractional exponent), zero is raised to a negative power or the result overflows
+func (p *MathPower) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	base, ok := toFloat64(inputs["base"])
+	if !ok {
+		return map[string]interface{}{"result": 0, "error": "base must be a number"}
+	}
+	exponent, ok := toFloat64(inputs["exponent"])
+	if !ok {
+		return map[string]interface{}{"result": 0, "error": "exponent must be a number"}
+	}
+
+	switch {
+	case base < 0 && exponent != math.Trunc(exponent):
+		return map[string]interface{}{"result": 0, "error": "negative base with a fractional exponent has no real result"}
+	case base == 0 && exponent < 0:
+		return map[string]interface{}{"result": 0, "error": "division by zero"}
+	}
+
+	result := math.Pow(base, exponent)
+	if math.IsInf(result, 0) || math.IsNaN(result) {
+		return map[string]interface{}{"result": 0, "error": "result overflows"}
+	}
+
+	return map[string]interface{}{"result": result}
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,7 @@
+// Package math_round provides factory for MathRound plugin.
+package math_round
+
+// Create returns a new MathRound instance.
+func Create() *MathRound {
+	return NewMathRound()
+}
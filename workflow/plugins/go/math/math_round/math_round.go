@@ -0,0 +1,140 @@
+// Package math_round provides a workflow plugin for rounding numbers.
+package math_round
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// MathRound implements the NodeExecutor interface for rounding numbers.
+type MathRound struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewMathRound creates a new MathRound instance.
+func NewMathRound() *MathRound {
+	return &MathRound{
+		NodeType:    "math.round",
+		Category:    "math",
+		Description: "Round a number to a given precision",
+	}
+}
+
+// maxPrecision bounds precision to the decimal range of float64.
+const maxPrecision = 308
+
+// Execute runs the plugin logic.
+// Rounding works on the decimal value as written, not its binary
+// approximation, so 1.005 rounds to 1.01 and 2.675 to 2.68. Modes:
+//   - half_up: to the nearest, halves away from zero (2.5 → 3, -2.5 → -3)
+//   - half_even: to the nearest, halves to the even neighbour (2.5 → 2, 3.5 → 4), as banks do
+//   - floor: down, toward negative infinity
+//   - ceil: up, toward positive infinity
+//   - truncate: toward zero
+//
+// Inputs:
+//   - value: the number to round
+//   - precision: (optional) decimal places to keep, up to 308; negative values round to tens, hundreds and so on (default: 0)
+//   - mode: (optional) rounding mode (default: "half_up")
+//
+// Returns:
+//   - result: the rounded number
+//   - formatted: the rounded number as a string with exactly precision decimal places, e.g. "1.50"
+//   - error: set if value is missing or not finite, or precision or mode is invalid
+func (p *MathRound) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(msg string) map[string]interface{} {
+		return map[string]interface{}{"result": 0, "formatted": "", "error": msg}
+	}
+	value, ok := toFloat64(inputs["value"])
+	if !ok {
+		return fail("value must be a number")
+	}
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return fail("value must be finite")
+	}
+	precision := 0
+	if n, ok := toFloat64(inputs["precision"]); ok {
+		precision = int(n)
+	}
+	if precision < -maxPrecision || precision > maxPrecision {
+		return fail(fmt.Sprintf("precision must be between %d and %d", -maxPrecision, maxPrecision))
+	}
+	mode, _ := inputs["mode"].(string)
+	if mode == "" {
+		mode = "half_up"
+	}
+
+	// Take the shortest decimal that reads back as value, as it would be
+	// printed, and scale it so that rounding is to a whole number
+	x, _ := new(big.Rat).SetString(strconv.FormatFloat(value, 'g', -1, 64))
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(precision))), nil))
+	if precision >= 0 {
+		x.Mul(x, scale)
+	} else {
+		x.Quo(x, scale)
+	}
+
+	q, rem := new(big.Int).QuoRem(x.Num(), x.Denom(), new(big.Int))
+	// twice the remainder against the denominator tells below, at or above half
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	cmp := half.Cmp(x.Denom())
+	step := int64(rem.Sign())
+
+	var up bool
+	switch mode {
+	case "half_up":
+		up = cmp >= 0
+	case "half_even":
+		up = cmp > 0 || cmp == 0 && q.Bit(0) == 1
+	case "floor":
+		up = step < 0
+	case "ceil":
+		up = step > 0
+	case "truncate":
+	default:
+		return fail(fmt.Sprintf("unknown mode %q (want half_up, half_even, floor, ceil or truncate)", mode))
+	}
+	if up && step != 0 {
+		q.Add(q, big.NewInt(step))
+	}
+
+	rounded := new(big.Rat).SetInt(q)
+	if precision >= 0 {
+		rounded.Quo(rounded, scale)
+	} else {
+		rounded.Mul(rounded, scale)
+	}
+	result, _ := rounded.Float64()
+	formatted := rounded.FloatString(max(precision, 0))
+	if result == 0 {
+		// Avoid "-0" after rounding a small negative number
+		result = 0
+	}
+
+	return map[string]interface{}{"result": result, "formatted": formatted}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,7 @@
+// Package math_sqrt provides factory for MathSqrt plugin.
+package math_sqrt
+
+// Create returns a new MathSqrt instance.
+func Create() *MathSqrt {
+	return NewMathSqrt()
+}
@@ -0,0 +1,87 @@
+// Package math_sqrt provides a workflow plugin for square and nth roots.
+package math_sqrt
+
+import (
+	"math"
+)
+
+// MathSqrt implements the NodeExecutor interface for taking roots.
+type MathSqrt struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewMathSqrt creates a new MathSqrt instance.
+func NewMathSqrt() *MathSqrt {
+	return &MathSqrt{
+		NodeType:    "math.sqrt",
+		Category:    "math",
+		Description: "Square or nth root of a number",
+	}
+}
+
+// Execute runs the plugin logic.
+// Odd roots of negative numbers are negative (the cube root of -8 is -2);
+// even roots of negative numbers are an error. Exact roots come out exact,
+// so the cube root of 27 is 3 rather than 3.0000000000000004.
+// Inputs:
+//   - value: the number to take the root of
+//   - root: (optional) which root to take, a positive integer (default: 2)
+//
+// Returns:
+//   - result: the root
+//   - error: set if value is missing, root is not a positive integer or an even root of a negative number is asked for
+func (p *MathSqrt) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	value, ok := toFloat64(inputs["value"])
+	if !ok {
+		return map[string]interface{}{"result": 0, "error": "value must be a number"}
+	}
+	root := 2.0
+	if r, ok := toFloat64(inputs["root"]); ok {
+		root = r
+	}
+	if root < 1 || root != math.Trunc(root) {
+		return map[string]interface{}{"result": 0, "error": "root must be a positive integer"}
+	}
+
+	odd := math.Mod(root, 2) == 1
+	if value < 0 && !odd {
+		return map[string]interface{}{"result": 0, "error": "even root of a negative number has no real result"}
+	}
+
+	var result float64
+	switch root {
+	case 1:
+		result = value
+	case 2:
+		result = math.Sqrt(value)
+	case 3:
+		result = math.Cbrt(value)
+	default:
+		result = math.Pow(math.Abs(value), 1/root)
+		if value < 0 {
+			result = -result
+		}
+		// Pow with a reciprocal exponent is often off by one unit in the
+		// last place; prefer a nearby integer that is an exact root
+		if r := math.Round(result); r != result && math.Pow(r, root) == value {
+			result = r
+		}
+	}
+
+	return map[string]interface{}{"result": result}
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
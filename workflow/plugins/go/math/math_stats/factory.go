@@ -0,0 +1,7 @@
+// Package math_stats provides factory for MathStats plugin.
+package math_stats
+
+// Create returns a new MathStats instance.
+func Create() *MathStats {
+	return NewMathStats()
+}
@@ -0,0 +1,154 @@
+// Package math_stats provides a workflow plugin for descriptive statistics.
+package math_stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// MathStats implements the NodeExecutor interface for statistics over numbers.
+type MathStats struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewMathStats creates a new MathStats instance.
+func NewMathStats() *MathStats {
+	return &MathStats{
+		NodeType:    "math.stats",
+		Category:    "math",
+		Description: "Median, percentiles, variance and standard deviation of numbers",
+	}
+}
+
+// Execute runs the plugin logic.
+// Elements that are not numbers are left out and counted in skipped; the
+// statistics are null when no numbers remain. Percentiles interpolate
+// linearly between the closest ranks, like Excel's PERCENTILE.INC and
+// NumPy's default, and are keyed "p" plus the percentile, e.g. "p95" or
+// "p99.9". Variance is the population variance unless sample is set, which
+// divides by count - 1 instead.
+// Inputs:
+//   - numbers: list of numbers
+//   - percentiles: (optional) list of percentiles between 0 and 100 (default: [])
+//   - sample: (optional) compute sample rather than population variance and standard deviation (default: false)
+//
+// Returns:
+//   - count: number of numbers
+//   - mean: the arithmetic mean
+//   - median: the middle value
+//   - min: the smallest number
+//   - max: the largest number
+//   - variance: the variance
+//   - stddev: the standard deviation
+//   - percentiles: dictionary of the requested percentiles
+//   - skipped: number of elements that were not numbers
+//   - error: set if numbers is not a list or a percentile is out of range
+func (p *MathStats) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(msg string) map[string]interface{} {
+		return map[string]interface{}{"count": 0, "percentiles": map[string]interface{}{}, "skipped": 0, "error": msg}
+	}
+	list, ok := inputs["numbers"].([]interface{})
+	if !ok {
+		return fail("numbers must be an array")
+	}
+	var ranks []float64
+	if raw, ok := inputs["percentiles"].([]interface{}); ok {
+		for _, r := range raw {
+			pct, ok := toFloat(r)
+			if !ok || pct < 0 || pct > 100 {
+				return fail(fmt.Sprintf("percentile %v must be a number between 0 and 100", r))
+			}
+			ranks = append(ranks, pct)
+		}
+	}
+	sample, _ := inputs["sample"].(bool)
+
+	// Mean and variance use Welford's method, which stays accurate for
+	// large values with a small spread
+	numbers := make([]float64, 0, len(list))
+	var mean, m2 float64
+	for _, item := range list {
+		n, ok := toFloat(item)
+		if !ok || math.IsNaN(n) {
+			continue
+		}
+		numbers = append(numbers, n)
+		delta := n - mean
+		mean += delta / float64(len(numbers))
+		m2 += delta * (n - mean)
+	}
+	count := len(numbers)
+	result := map[string]interface{}{
+		"count":       count,
+		"mean":        nil,
+		"median":      nil,
+		"min":         nil,
+		"max":         nil,
+		"variance":    nil,
+		"stddev":      nil,
+		"percentiles": map[string]interface{}{},
+		"skipped":     len(list) - count,
+	}
+	if count == 0 {
+		return result
+	}
+
+	sort.Float64s(numbers)
+	result["mean"] = mean
+	result["median"] = percentile(numbers, 50)
+	result["min"] = numbers[0]
+	result["max"] = numbers[count-1]
+
+	if divisor := count - boolToInt(sample); divisor > 0 {
+		variance := m2 / float64(divisor)
+		result["variance"] = variance
+		result["stddev"] = math.Sqrt(variance)
+	}
+
+	percentiles := make(map[string]interface{}, len(ranks))
+	for _, r := range ranks {
+		percentiles["p"+strconv.FormatFloat(r, 'f', -1, 64)] = percentile(numbers, r)
+	}
+	result["percentiles"] = percentiles
+
+	return result
+}
+
+// percentile returns the pct-th percentile of sorted, non-empty numbers.
+func percentile(sorted []float64, pct float64) float64 {
+	pos := pct / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	if lo >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	frac := pos - float64(lo)
+	return sorted[lo] + frac*(sorted[lo+1]-sorted[lo])
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
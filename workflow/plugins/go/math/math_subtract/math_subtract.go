@@ -1,6 +1,13 @@
 // Package math_subtract provides a workflow plugin for subtracting numbers.
 package math_subtract
 
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/metabuilder/workflow-plugins-go/decimal"
+)
+
 // MathSubtract implements the NodeExecutor interface for subtracting numbers.
 type MathSubtract struct {
 	NodeType    string
@@ -18,12 +25,27 @@ func NewMathSubtract() *MathSubtract {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - numbers: list of numbers, subsequent numbers are subtracted from the first
+//   - precision: (optional) "float", or "decimal" for exact decimal arithmetic on numbers and numeric strings, with the result as a string (default: "float")
+//
+// Returns:
+//   - result: the difference
+//   - error: set if numbers is not a non-empty list, or in decimal precision an element is not a number or a result needs more than 4000 digits
 func (p *MathSubtract) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	numbers, ok := inputs["numbers"].([]interface{})
 	if !ok || len(numbers) == 0 {
 		return map[string]interface{}{"result": 0, "error": "numbers must be a non-empty array"}
 	}
 
+	switch precision, _ := inputs["precision"].(string); precision {
+	case "", "float":
+	case "decimal":
+		return subtractDecimal(numbers)
+	default:
+		return map[string]interface{}{"result": 0, "error": fmt.Sprintf("unknown precision %q (want float or decimal)", precision)}
+	}
+
 	result := toFloat64(numbers[0])
 	for i := 1; i < len(numbers); i++ {
 		result -= toFloat64(numbers[i])
@@ -32,6 +54,27 @@ func (p *MathSubtract) Execute(inputs map[string]interface{}, runtime interface{
 	return map[string]interface{}{"result": result}
 }
 
+// subtractDecimal computes the difference exactly.
+func subtractDecimal(numbers []interface{}) map[string]interface{} {
+	result := new(big.Rat)
+	for i, n := range numbers {
+		r, err := decimal.Parse(n)
+		if err != nil {
+			return map[string]interface{}{"result": 0, "error": fmt.Sprintf("numbers[%d]: %v", i, err)}
+		}
+		if i == 0 {
+			result.Set(r)
+		} else {
+			result.Sub(result, r)
+		}
+		if err := decimal.Check(result); err != nil {
+			return map[string]interface{}{"result": 0, "error": fmt.Sprintf("numbers[%d]: %v", i, err)}
+		}
+	}
+
+	return map[string]interface{}{"result": decimal.String(result, decimal.DefaultScale)}
+}
+
 func toFloat64(v interface{}) float64 {
 	switch n := v.(type) {
 	case float64:
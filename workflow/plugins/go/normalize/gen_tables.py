@@ -0,0 +1,78 @@
+#!/usr/bin/env python3
+"""Generate tables.go for the normalize package from Python's unicodedata.
+
+Run with `go generate ./normalize`; the Unicode version is that of the
+Python interpreter used.
+"""
+import subprocess
+import sys
+import unicodedata
+
+SBASE, SCOUNT = 0xAC00, 11172
+
+
+def is_hangul(cp):
+    return SBASE <= cp < SBASE + SCOUNT
+
+
+def chars():
+    for cp in range(sys.maxunicode + 1):
+        if 0xD800 <= cp <= 0xDFFF or is_hangul(cp):
+            continue
+        yield cp, chr(cp)
+
+
+def go_string(s):
+    return '"' + "".join("\\U%08X" % ord(c) if ord(c) > 0xFFFF else
+                         "\\u%04X" % ord(c) if ord(c) > 0x7E or c in '"\\' or ord(c) < 0x20 else c
+                         for c in s) + '"'
+
+
+canonical, compat, ccc, compose = {}, {}, {}, {}
+for cp, ch in chars():
+    nfd = unicodedata.normalize("NFD", ch)
+    if nfd != ch:
+        canonical[cp] = nfd
+    nfkd = unicodedata.normalize("NFKD", ch)
+    if nfkd != nfd:
+        compat[cp] = nfkd
+    c = unicodedata.combining(ch)
+    if c:
+        ccc[cp] = c
+    dec = unicodedata.decomposition(ch)
+    if dec and not dec.startswith("<"):
+        parts = [int(x, 16) for x in dec.split()]
+        # primary composites: pairs that NFC recomposes into this character
+        if len(parts) == 2 and unicodedata.normalize("NFC", chr(parts[0]) + chr(parts[1])) == ch:
+            compose[(parts[0], parts[1])] = cp
+
+out = []
+w = out.append
+w("// Code generated by gen_tables.py from Unicode %s data; DO NOT EDIT.\n\n" % unicodedata.unidata_version)
+w("package normalize\n\n")
+w("// unicodeVersion is the version of the Unicode data the tables come from.\n")
+w('const unicodeVersion = "%s"\n\n' % unicodedata.unidata_version)
+w("// canonical maps characters to their full canonical decomposition.\n")
+w("var canonical = map[rune]string{\n")
+for cp in sorted(canonical):
+    w("\t0x%04X: %s,\n" % (cp, go_string(canonical[cp])))
+w("}\n\n")
+w("// compat maps characters to their full compatibility decomposition, where\n")
+w("// it differs from the canonical one.\n")
+w("var compat = map[rune]string{\n")
+for cp in sorted(compat):
+    w("\t0x%04X: %s,\n" % (cp, go_string(compat[cp])))
+w("}\n\n")
+w("// combiningClass holds the non-zero canonical combining classes.\n")
+w("var combiningClass = map[rune]uint8{\n")
+for cp in sorted(ccc):
+    w("\t0x%04X: %d,\n" % (cp, ccc[cp]))
+w("}\n\n")
+w("// composition maps pairs of characters to their primary composite.\n")
+w("var composition = map[[2]rune]rune{\n")
+for a, b in sorted(compose):
+    w("\t{0x%04X, 0x%04X}: 0x%04X,\n" % (a, b, compose[(a, b)]))
+w("}\n")
+
+open("tables.go", "w").write("".join(out))
+subprocess.run(["gofmt", "-w", "tables.go"], check=True)
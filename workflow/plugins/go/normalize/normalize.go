@@ -0,0 +1,158 @@
+// Package normalize implements the Unicode normalization forms (NFC, NFD,
+// NFKC and NFKD) with tables generated from the Unicode Character
+// Database, since the standard library does not include them.
+//
+// "café" written with a precomposed é and with e plus a combining accent
+// look the same but compare unequal; both normalize to the same NFC (or
+// NFD) string.
+package normalize
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+//go:generate python3 gen_tables.py
+
+// Forms lists the names accepted by String.
+var Forms = []string{"NFC", "NFD", "NFKC", "NFKD"}
+
+// String normalizes s to the named form. It returns an error for unknown
+// forms.
+func String(s, form string) (string, error) {
+	switch strings.ToUpper(form) {
+	case "NFC":
+		return NFC(s), nil
+	case "NFD":
+		return NFD(s), nil
+	case "NFKC":
+		return NFKC(s), nil
+	case "NFKD":
+		return NFKD(s), nil
+	}
+	return "", fmt.Errorf("unknown normalization form %q (want one of %s)", form, strings.Join(Forms, ", "))
+}
+
+// NFC returns the canonical composition of s.
+func NFC(s string) string { return string(compose(decompose(s, false))) }
+
+// NFD returns the canonical decomposition of s.
+func NFD(s string) string { return string(decompose(s, false)) }
+
+// NFKC returns the compatibility composition of s, which also folds
+// variants such as ligatures, full-width forms and superscripts.
+func NFKC(s string) string { return string(compose(decompose(s, true))) }
+
+// NFKD returns the compatibility decomposition of s.
+func NFKD(s string) string { return string(decompose(s, true)) }
+
+// StripMarks removes diacritics: s is decomposed, its nonspacing marks are
+// dropped and the rest is recomposed, so "Crème brûlée" becomes
+// "Creme brulee". Letters that are not composed with a mark, such as ø or
+// ß, are kept.
+func StripMarks(s string) string {
+	runes := decompose(s, false)
+	kept := runes[:0]
+	for _, r := range runes {
+		if !unicode.Is(unicode.Mn, r) {
+			kept = append(kept, r)
+		}
+	}
+	return string(compose(kept))
+}
+
+// Version returns the Unicode version of the tables.
+func Version() string { return unicodeVersion }
+
+// Hangul syllables are decomposed and composed arithmetically.
+const (
+	sBase  = 0xAC00
+	lBase  = 0x1100
+	vBase  = 0x1161
+	tBase  = 0x11A7
+	lCount = 19
+	vCount = 21
+	tCount = 28
+	nCount = vCount * tCount
+	sCount = lCount * nCount
+)
+
+// decompose returns the full decomposition of s in canonical order.
+func decompose(s string, compatibility bool) []rune {
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		if r >= sBase && r < sBase+sCount {
+			i := r - sBase
+			out = append(out, lBase+i/nCount, vBase+(i%nCount)/tCount)
+			if t := i % tCount; t != 0 {
+				out = append(out, tBase+t)
+			}
+			continue
+		}
+		if compatibility {
+			if d, ok := compat[r]; ok {
+				out = append(out, []rune(d)...)
+				continue
+			}
+		}
+		if d, ok := canonical[r]; ok {
+			out = append(out, []rune(d)...)
+			continue
+		}
+		out = append(out, r)
+	}
+
+	// Sort each run of combining marks by combining class
+	for i := 0; i < len(out); {
+		if combiningClass[out[i]] == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(out) && combiningClass[out[j]] != 0 {
+			j++
+		}
+		run := out[i:j]
+		sort.SliceStable(run, func(a, b int) bool { return combiningClass[run[a]] < combiningClass[run[b]] })
+		i = j
+	}
+	return out
+}
+
+// compose applies canonical composition to decomposed, ordered runes.
+func compose(runes []rune) []rune {
+	out := runes[:0]
+	starter := -1
+	var last uint8
+	for _, r := range runes {
+		cc := combiningClass[r]
+		// r can combine with the last starter when nothing in between
+		// blocks it: a starter or a mark of the same or higher class
+		if starter >= 0 && (len(out)-1 == starter || last != 0 && last < cc) {
+			if c, ok := pair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+		if cc == 0 {
+			starter = len(out)
+		}
+		last = cc
+		out = append(out, r)
+	}
+	return out
+}
+
+// pair returns the primary composite of a and b, if there is one.
+func pair(a, b rune) (rune, bool) {
+	switch {
+	case a >= lBase && a < lBase+lCount && b >= vBase && b < vBase+vCount:
+		return sBase + ((a-lBase)*vCount+(b-vBase))*tCount, true
+	case a >= sBase && a < sBase+sCount && (a-sBase)%tCount == 0 && b > tBase && b < tBase+tCount:
+		return a + (b - tBase), true
+	}
+	c, ok := composition[[2]rune{a, b}]
+	return c, ok
+}
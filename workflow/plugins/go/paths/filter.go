@@ -0,0 +1,186 @@
+package paths
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Filter selects the children of a map or list that satisfy a condition,
+// written as a JSONPath-style bracket segment:
+//
+//	users[?(@.age >= 18)].email
+//	items[?(@.tags)]           // field exists and is not null or false
+//	scores[?(@ > 10)]          // compares the element itself
+//
+// The operand is a JSON literal; strings may also use single quotes.
+// Numbers compare numerically and strings lexically.
+type Filter struct {
+	Path  Path        // field relative to the child; empty for the child itself
+	Op    string      // ==, !=, <, <=, > or >=; empty tests for a truthy field
+	Value interface{} // operand
+}
+
+var filterOps = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+// parseFilter parses the text between "[?" and "]".
+func parseFilter(s string) (*Filter, error) {
+	expr := strings.TrimSpace(s)
+	if strings.HasPrefix(expr, "(") && strings.HasSuffix(expr, ")") {
+		expr = strings.TrimSpace(expr[1 : len(expr)-1])
+	}
+	if !strings.HasPrefix(expr, "@") {
+		return nil, fmt.Errorf("filter %q must start with @", s)
+	}
+	expr = expr[1:]
+
+	// Split at the first operator outside escapes
+	field, op, operand := expr, "", ""
+	for i := 0; i < len(expr); i++ {
+		if expr[i] == '\\' {
+			i++
+			continue
+		}
+		for _, candidate := range filterOps {
+			if strings.HasPrefix(expr[i:], candidate) {
+				field, op, operand = expr[:i], candidate, expr[i+len(candidate):]
+				break
+			}
+		}
+		if op != "" {
+			break
+		}
+	}
+
+	f := &Filter{Op: op}
+	field = strings.TrimSpace(field)
+	if field != "" {
+		if !strings.HasPrefix(field, ".") {
+			return nil, fmt.Errorf("filter %q: expected . after @", s)
+		}
+		path, err := Parse(field[1:])
+		if err != nil {
+			return nil, err
+		}
+		if path.HasWildcard() {
+			return nil, fmt.Errorf("filter %q: field must not contain wildcards", s)
+		}
+		f.Path = path
+	}
+	if op == "" {
+		return f, nil
+	}
+
+	operand = strings.TrimSpace(operand)
+	if len(operand) >= 2 && operand[0] == '\'' && operand[len(operand)-1] == '\'' {
+		f.Value = operand[1 : len(operand)-1]
+		return f, nil
+	}
+	if err := json.Unmarshal([]byte(operand), &f.Value); err != nil {
+		return nil, fmt.Errorf("filter %q: operand %q is not a JSON value", s, operand)
+	}
+	return f, nil
+}
+
+// Match reports whether v satisfies the filter.
+func (f *Filter) Match(v interface{}) bool {
+	field, ok := Get(v, f.Path)
+	if !ok {
+		return false
+	}
+	switch f.Op {
+	case "":
+		return field != nil && field != false
+	case "==":
+		return equal(field, f.Value)
+	case "!=":
+		return !equal(field, f.Value)
+	}
+	cmp, ok := compare(field, f.Value)
+	if !ok {
+		return false
+	}
+	switch f.Op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// String formats the filter as a bracket segment.
+func (f *Filter) String() string {
+	var b strings.Builder
+	b.WriteString("[?(@")
+	if len(f.Path) > 0 {
+		b.WriteByte('.')
+		b.WriteString(f.Path.String())
+	}
+	if f.Op != "" {
+		operand, _ := json.Marshal(f.Value)
+		fmt.Fprintf(&b, " %s %s", f.Op, operand)
+	}
+	b.WriteString(")]")
+	return b.String()
+}
+
+// selects reports whether a wildcard or filter segment includes v.
+func (s Segment) selects(v interface{}) bool {
+	return s.Filter == nil || s.Filter.Match(v)
+}
+
+func equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func compare(a, b interface{}) (int, bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	sa, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	sb, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(sa, sb), true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
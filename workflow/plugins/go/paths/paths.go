@@ -0,0 +1,751 @@
+// Package paths parses and resolves the dot-notation paths used by the dict
+// plugins to address values inside nested maps and lists.
+//
+// Syntax:
+//   - segments are separated by dots: user.name
+//   - a backslash escapes the next character, so keys may contain dots,
+//     brackets or asterisks: config.a\.b
+//   - list elements are addressed in brackets, negative indices count from
+//     the end: items[0].name, items[-1]
+//   - a numeric segment also indexes a list: items.0.name
+//   - * (or [*]) matches every key of a map or element of a list: users.*.email
+//   - [?(...)] matches the children satisfying a condition, see Filter:
+//     users[?(@.active == true)].email
+//
+// Set and Delete never modify their input: maps and lists along the changed
+// path are copied, everything else is shared with the original.
+package paths
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Segment is one step of a path.
+type Segment struct {
+	Key      string // map key, when not an index or wildcard
+	Index    int    // list index, when IsIndex is set
+	IsIndex  bool   // segment was written in brackets, e.g. [2]
+	Wildcard bool   // segment matches every child, or those selected by Filter
+	Filter   *Filter
+}
+
+// Path is a parsed path. The empty path addresses the root value.
+type Path []Segment
+
+// Parse parses a path string.
+func Parse(s string) (Path, error) {
+	var path Path
+	var key strings.Builder
+	inKey := false        // a key segment is being read
+	escaped := false      // the current key contains an escape, so "*" is literal
+	afterBracket := false // the previous segment was bracketed
+
+	endKey := func() {
+		if key.String() == "*" && !escaped {
+			path = append(path, Segment{Wildcard: true})
+		} else {
+			path = append(path, Segment{Key: key.String()})
+		}
+		key.Reset()
+		inKey, escaped = false, false
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 == len(s) {
+				return nil, fmt.Errorf("path %q: trailing backslash", s)
+			}
+			if afterBracket {
+				return nil, fmt.Errorf("path %q: expected . or [ at offset %d", s, i)
+			}
+			i++
+			key.WriteByte(s[i])
+			inKey, escaped = true, true
+		case '.':
+			if !inKey && !afterBracket {
+				return nil, fmt.Errorf("path %q: empty segment at offset %d", s, i)
+			}
+			if i == len(s)-1 {
+				return nil, fmt.Errorf("path %q: empty segment at end", s)
+			}
+			if inKey {
+				endKey()
+			}
+			afterBracket = false
+		case '[':
+			if inKey {
+				endKey()
+			}
+			end := closingBracket(s[i:])
+			if end < 0 {
+				return nil, fmt.Errorf("path %q: unclosed bracket at offset %d", s, i)
+			}
+			inner := s[i+1 : i+end]
+			if strings.HasPrefix(inner, "?") {
+				f, err := parseFilter(inner[1:])
+				if err != nil {
+					return nil, fmt.Errorf("path %q: %v", s, err)
+				}
+				path = append(path, Segment{Wildcard: true, Filter: f})
+			} else if inner == "*" {
+				path = append(path, Segment{Wildcard: true})
+			} else {
+				n, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("path %q: invalid index %q", s, inner)
+				}
+				path = append(path, Segment{Index: n, IsIndex: true})
+			}
+			i += end
+			afterBracket = true
+		default:
+			if afterBracket {
+				return nil, fmt.Errorf("path %q: expected . or [ at offset %d", s, i)
+			}
+			key.WriteByte(c)
+			inKey = true
+		}
+	}
+	if inKey {
+		endKey()
+	}
+	return path, nil
+}
+
+// ParsePointer parses an RFC 6901 JSON Pointer such as "/items/0/name".
+// Every token becomes a key segment; numeric keys address list elements as
+// in Parse. The empty pointer addresses the root value.
+func ParsePointer(s string) (Path, error) {
+	if s == "" {
+		return Path{}, nil
+	}
+	if s[0] != '/' {
+		return nil, fmt.Errorf("pointer %q: must start with /", s)
+	}
+	tokens := strings.Split(s[1:], "/")
+	path := make(Path, len(tokens))
+	for i, tok := range tokens {
+		for j := 0; j < len(tok); j++ {
+			if tok[j] == '~' && (j+1 == len(tok) || (tok[j+1] != '0' && tok[j+1] != '1')) {
+				return nil, fmt.Errorf("pointer %q: invalid escape in %q", s, tok)
+			}
+		}
+		path[i] = Segment{Key: strings.NewReplacer("~1", "/", "~0", "~").Replace(tok)}
+	}
+	return path, nil
+}
+
+// closingBracket returns the offset of the "]" closing the bracket at the
+// start of s, skipping brackets inside quoted filter operands, or -1.
+func closingBracket(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+// MustParse is like Parse but panics on error. It is meant for constant paths.
+func MustParse(s string) Path {
+	path, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return path
+}
+
+// String formats the path in the syntax accepted by Parse.
+func (p Path) String() string {
+	var b strings.Builder
+	for i, seg := range p {
+		switch {
+		case seg.Filter != nil:
+			b.WriteString(seg.Filter.String())
+		case seg.Wildcard:
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteByte('*')
+		case seg.IsIndex:
+			fmt.Fprintf(&b, "[%d]", seg.Index)
+		default:
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			if seg.Key == "*" {
+				b.WriteString(`\*`)
+				continue
+			}
+			for j := 0; j < len(seg.Key); j++ {
+				switch c := seg.Key[j]; c {
+				case '.', '[', ']', '\\':
+					b.WriteByte('\\')
+					b.WriteByte(c)
+				default:
+					b.WriteByte(c)
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
+// Pointer formats the path as an RFC 6901 JSON Pointer, e.g. "/items/0/name".
+// Wildcard segments are written as "*".
+func (p Path) Pointer() string {
+	var b strings.Builder
+	for _, seg := range p {
+		b.WriteByte('/')
+		switch {
+		case seg.Wildcard:
+			b.WriteByte('*')
+		case seg.IsIndex:
+			b.WriteString(strconv.Itoa(seg.Index))
+		default:
+			b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(seg.Key))
+		}
+	}
+	return b.String()
+}
+
+// HasWildcard reports whether the path contains a wildcard or filter segment.
+func (p Path) HasWildcard() bool {
+	for _, seg := range p {
+		if seg.Wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// listIndex returns the list position addressed by seg, if any.
+// Bracketed indices and numeric keys both address list elements.
+func listIndex(seg Segment, length int) (int, bool) {
+	idx := seg.Index
+	if !seg.IsIndex {
+		n, err := strconv.Atoi(seg.Key)
+		if err != nil {
+			return 0, false
+		}
+		idx = n
+	}
+	if idx < 0 {
+		idx += length
+	}
+	if idx < 0 || idx >= length {
+		return 0, false
+	}
+	return idx, true
+}
+
+// child returns the value one segment below node.
+func child(node interface{}, seg Segment) (interface{}, bool) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if seg.IsIndex || seg.Wildcard {
+			return nil, false
+		}
+		v, ok := n[seg.Key]
+		return v, ok
+	case []interface{}:
+		idx, ok := listIndex(seg, len(n))
+		if !ok {
+			return nil, false
+		}
+		return n[idx], true
+	default:
+		return nil, false
+	}
+}
+
+// Resolve walks path from root. It returns the value found and true, or the
+// number of segments that resolved before the walk failed and false.
+// Paths with wildcards never resolve; use Expand.
+func Resolve(root interface{}, path Path) (value interface{}, depth int, ok bool) {
+	current := root
+	for i, seg := range path {
+		next, ok := child(current, seg)
+		if !ok {
+			return nil, i, false
+		}
+		current = next
+	}
+	return current, len(path), true
+}
+
+// Get returns the value at path and whether it exists. A key holding nil
+// exists.
+func Get(root interface{}, path Path) (interface{}, bool) {
+	value, _, ok := Resolve(root, path)
+	return value, ok
+}
+
+// Match is one value found by Expand, with its concrete path: wildcards are
+// replaced by the keys and indices they matched.
+type Match struct {
+	Path  Path
+	Value interface{}
+}
+
+// Expand returns every value addressed by a path that may contain wildcards,
+// in key order for maps and element order for lists.
+func Expand(root interface{}, path Path) []Match {
+	var matches []Match
+	var walk func(node interface{}, i int, prefix Path)
+	walk = func(node interface{}, i int, prefix Path) {
+		if i == len(path) {
+			matches = append(matches, Match{Path: append(Path(nil), prefix...), Value: node})
+			return
+		}
+		seg := path[i]
+		if !seg.Wildcard {
+			next, ok := child(node, seg)
+			if !ok {
+				return
+			}
+			// Record list positions as non-negative indices so the
+			// match's path can be reused with Set
+			if list, isList := node.([]interface{}); isList {
+				idx, _ := listIndex(seg, len(list))
+				seg = Segment{Index: idx, IsIndex: true}
+			}
+			walk(next, i+1, append(prefix, seg))
+			return
+		}
+		switch n := node.(type) {
+		case map[string]interface{}:
+			for _, k := range sortedKeys(n) {
+				if seg.selects(n[k]) {
+					walk(n[k], i+1, append(prefix, Segment{Key: k}))
+				}
+			}
+		case []interface{}:
+			for idx, item := range n {
+				if seg.selects(item) {
+					walk(item, i+1, append(prefix, Segment{Index: idx, IsIndex: true}))
+				}
+			}
+		}
+	}
+	walk(root, 0, nil)
+	return matches
+}
+
+// Set returns a copy of root with value stored at path. Missing maps along
+// the path are created, and values that are neither maps nor lists are
+// replaced by maps. Bracketed indices may address an existing element or
+// append one past the end. Wildcards and filters set every existing child
+// they select.
+func Set(root interface{}, path Path, value interface{}) (interface{}, error) {
+	return newBatch().set(root, path, 0, value)
+}
+
+// SetMany applies several assignments in order, like repeated calls to Set,
+// but copies each map and list at most once. paths and values must have the
+// same length.
+func SetMany(root interface{}, ps []Path, values []interface{}) (interface{}, error) {
+	if len(ps) != len(values) {
+		return nil, fmt.Errorf("paths: %d paths for %d values", len(ps), len(values))
+	}
+	b := newBatch()
+	for i, path := range ps {
+		var err error
+		if root, err = b.set(root, path, 0, values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// batch tracks the containers copied during a set, so later assignments in
+// the same batch can modify them in place.
+type batch struct {
+	owned map[uintptr]bool
+}
+
+func newBatch() *batch {
+	return &batch{owned: map[uintptr]bool{}}
+}
+
+func (b *batch) ownMap(m map[string]interface{}) map[string]interface{} {
+	if b.owned[reflect.ValueOf(m).Pointer()] {
+		return m
+	}
+	result := copyMap(m)
+	b.owned[reflect.ValueOf(result).Pointer()] = true
+	return result
+}
+
+func (b *batch) ownList(l []interface{}) []interface{} {
+	if len(l) > 0 && b.owned[reflect.ValueOf(l).Pointer()] {
+		return l
+	}
+	result := append([]interface{}(nil), l...)
+	b.markList(result)
+	return result
+}
+
+func (b *batch) markList(l []interface{}) {
+	if len(l) > 0 {
+		b.owned[reflect.ValueOf(l).Pointer()] = true
+	}
+}
+
+func (b *batch) set(node interface{}, path Path, i int, value interface{}) (interface{}, error) {
+	if i == len(path) {
+		return value, nil
+	}
+	seg := path[i]
+
+	switch n := node.(type) {
+	case []interface{}:
+		if seg.Wildcard {
+			result := b.ownList(n)
+			for idx, item := range result {
+				if !seg.selects(item) {
+					continue
+				}
+				v, err := b.set(item, path, i+1, value)
+				if err != nil {
+					return nil, err
+				}
+				result[idx] = v
+			}
+			return result, nil
+		}
+		idx, ok := listIndex(seg, len(n))
+		if !ok {
+			if seg.IsIndex && seg.Index == len(n) {
+				v, err := b.set(nil, path, i+1, value)
+				if err != nil {
+					return nil, err
+				}
+				result := append(b.ownList(n), v)
+				b.markList(result)
+				return result, nil
+			}
+			return nil, fmt.Errorf("%s: index out of range for list of length %d", path[:i+1], len(n))
+		}
+		result := b.ownList(n)
+		v, err := b.set(result[idx], path, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+		result[idx] = v
+		return result, nil
+	case map[string]interface{}:
+		if seg.IsIndex {
+			return nil, fmt.Errorf("%s: cannot index a map", path[:i+1])
+		}
+		result := b.ownMap(n)
+		if seg.Wildcard {
+			for k, item := range result {
+				if !seg.selects(item) {
+					continue
+				}
+				v, err := b.set(item, path, i+1, value)
+				if err != nil {
+					return nil, err
+				}
+				result[k] = v
+			}
+			return result, nil
+		}
+		v, err := b.set(result[seg.Key], path, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+		result[seg.Key] = v
+		return result, nil
+	default:
+		// Nothing to descend into: create the container the segment implies
+		switch {
+		case seg.Wildcard:
+			return node, nil
+		case seg.IsIndex:
+			if seg.Index != 0 {
+				return nil, fmt.Errorf("%s: index out of range for list of length 0", path[:i+1])
+			}
+			v, err := b.set(nil, path, i+1, value)
+			if err != nil {
+				return nil, err
+			}
+			result := []interface{}{v}
+			b.markList(result)
+			return result, nil
+		default:
+			v, err := b.set(nil, path, i+1, value)
+			if err != nil {
+				return nil, err
+			}
+			result := map[string]interface{}{seg.Key: v}
+			b.owned[reflect.ValueOf(result).Pointer()] = true
+			return result, nil
+		}
+	}
+}
+
+// Delete returns a copy of root without the value at path and whether
+// anything was removed. Deleting a list element shifts the following ones.
+// Wildcards and filters delete every match.
+func Delete(root interface{}, path Path) (interface{}, bool) {
+	if len(path) == 0 {
+		return root, false
+	}
+	return del(root, path, 0)
+}
+
+func del(node interface{}, path Path, i int) (interface{}, bool) {
+	seg := path[i]
+	last := i == len(path)-1
+
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if seg.IsIndex {
+			return node, false
+		}
+		if seg.Wildcard {
+			result := copyMap(n)
+			deleted := false
+			for k, item := range n {
+				if !seg.selects(item) {
+					continue
+				}
+				if last {
+					delete(result, k)
+					deleted = true
+				} else if v, ok := del(item, path, i+1); ok {
+					result[k] = v
+					deleted = true
+				}
+			}
+			return result, deleted
+		}
+		item, exists := n[seg.Key]
+		if !exists {
+			return node, false
+		}
+		result := copyMap(n)
+		if last {
+			delete(result, seg.Key)
+			return result, true
+		}
+		v, ok := del(item, path, i+1)
+		if !ok {
+			return node, false
+		}
+		result[seg.Key] = v
+		return result, true
+	case []interface{}:
+		if seg.Wildcard {
+			result := make([]interface{}, 0, len(n))
+			deleted := false
+			for _, item := range n {
+				if !seg.selects(item) {
+					result = append(result, item)
+					continue
+				}
+				if last {
+					deleted = true
+					continue
+				}
+				if v, ok := del(item, path, i+1); ok {
+					item = v
+					deleted = true
+				}
+				result = append(result, item)
+			}
+			if !deleted {
+				return node, false
+			}
+			return result, true
+		}
+		idx, ok := listIndex(seg, len(n))
+		if !ok {
+			return node, false
+		}
+		if last {
+			result := make([]interface{}, 0, len(n)-1)
+			result = append(result, n[:idx]...)
+			return append(result, n[idx+1:]...), true
+		}
+		v, ok := del(n[idx], path, i+1)
+		if !ok {
+			return node, false
+		}
+		result := append([]interface{}(nil), n...)
+		result[idx] = v
+		return result, true
+	default:
+		return node, false
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+// Pick returns a copy of root containing only the values addressed by the
+// given paths, with the maps and lists leading to them. Lists keep only the
+// picked elements, in their original order. Paths that match nothing are
+// ignored.
+func Pick(root interface{}, ps ...Path) interface{} {
+	t := newTrie(root, ps)
+	if t.end {
+		return root
+	}
+	return pick(root, t)
+}
+
+// Omit returns a copy of root without the values addressed by the given
+// paths. All paths are resolved against root, so list indices refer to the
+// original positions even when earlier paths remove elements. Omitting the
+// root itself leaves an empty dictionary or list of the same kind, or nil
+// for other values.
+func Omit(root interface{}, ps ...Path) interface{} {
+	t := newTrie(root, ps)
+	if t.end {
+		switch root.(type) {
+		case map[string]interface{}:
+			return map[string]interface{}{}
+		case []interface{}:
+			return []interface{}{}
+		}
+		return nil
+	}
+	return omit(root, t)
+}
+
+// trie holds a set of concrete paths, as produced by Expand.
+type trie struct {
+	end  bool
+	keys map[string]*trie
+	idx  map[int]*trie
+}
+
+func newTrie(root interface{}, ps []Path) *trie {
+	t := &trie{}
+	for _, p := range ps {
+		for _, m := range Expand(root, p) {
+			node := t
+			for _, seg := range m.Path {
+				node = node.child(seg)
+			}
+			node.end = true
+		}
+	}
+	return t
+}
+
+func (t *trie) child(seg Segment) *trie {
+	if seg.IsIndex {
+		if t.idx == nil {
+			t.idx = map[int]*trie{}
+		}
+		if t.idx[seg.Index] == nil {
+			t.idx[seg.Index] = &trie{}
+		}
+		return t.idx[seg.Index]
+	}
+	if t.keys == nil {
+		t.keys = map[string]*trie{}
+	}
+	if t.keys[seg.Key] == nil {
+		t.keys[seg.Key] = &trie{}
+	}
+	return t.keys[seg.Key]
+}
+
+// lookup returns the subtree for a map key or list position.
+func (t *trie) lookup(key string, idx int, inList bool) *trie {
+	if inList {
+		return t.idx[idx]
+	}
+	return t.keys[key]
+}
+
+func pick(node interface{}, t *trie) interface{} {
+	if t.end {
+		return node
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		result := map[string]interface{}{}
+		for k, v := range n {
+			if sub := t.lookup(k, 0, false); sub != nil {
+				result[k] = pick(v, sub)
+			}
+		}
+		return result
+	case []interface{}:
+		result := []interface{}{}
+		for idx, v := range n {
+			if sub := t.lookup("", idx, true); sub != nil {
+				result = append(result, pick(v, sub))
+			}
+		}
+		return result
+	default:
+		return node
+	}
+}
+
+func omit(node interface{}, t *trie) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			sub := t.lookup(k, 0, false)
+			switch {
+			case sub == nil:
+				result[k] = v
+			case !sub.end:
+				result[k] = omit(v, sub)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(n))
+		for idx, v := range n {
+			sub := t.lookup("", idx, true)
+			switch {
+			case sub == nil:
+				result = append(result, v)
+			case !sub.end:
+				result = append(result, omit(v, sub))
+			}
+		}
+		return result
+	default:
+		return node
+	}
+}
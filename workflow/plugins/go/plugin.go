@@ -6,11 +6,40 @@
 //   - Input/output are map[string]interface{}
 package plugin
 
+import (
+	"time"
+)
+
 // Runtime provides context for plugin execution.
 type Runtime struct {
 	Store   map[string]interface{} // Workflow state storage
 	Context map[string]interface{} // Shared context (clients, config)
 	Logger  Logger                 // Logging interface
+	Events  *EventBus              // In-run publish/subscribe bus
+}
+
+// GetStore returns the workflow state storage.
+// It satisfies the store interface used by the var.* plugins.
+func (r *Runtime) GetStore() map[string]interface{} {
+	return r.Store
+}
+
+// Publish sends an event on the run's event bus.
+// Returns the number of subscribers that were waiting for it.
+func (r *Runtime) Publish(topic string, payload interface{}) int {
+	if r.Events == nil {
+		return 0
+	}
+	return r.Events.Publish(topic, payload)
+}
+
+// WaitEvent waits for an event on the run's event bus.
+// See EventBus.WaitEvent for the meaning of timeout and replay.
+func (r *Runtime) WaitEvent(topic string, timeout time.Duration, replay bool) (interface{}, bool) {
+	if r.Events == nil {
+		return nil, false
+	}
+	return r.Events.WaitEvent(topic, timeout, replay)
 }
 
 // Logger interface for plugin logging.
@@ -27,6 +56,14 @@ type Plugin interface {
 	Run(runtime *Runtime, inputs map[string]interface{}) (map[string]interface{}, error)
 }
 
+// NodeExecutor is the interface implemented by every node plugin in this module.
+// Implementations also expose NodeType, Category and Description fields.
+type NodeExecutor interface {
+	// Execute runs the node with given inputs and runtime.
+	// Failures are reported through an "error" key in the output map.
+	Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{}
+}
+
 // PluginFunc is a function type that implements Plugin interface.
 type PluginFunc func(runtime *Runtime, inputs map[string]interface{}) (map[string]interface{}, error)
 
@@ -0,0 +1,206 @@
+// Package plugintest provides helpers for testing node plugins.
+package plugintest
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"sort"
+	"testing"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// Option configures RunConformance.
+type Option func(*config)
+
+type config struct {
+	cases      []map[string]interface{}
+	newRuntime func() interface{}
+	schema     *registry.Schema
+}
+
+// WithInputs adds representative input maps. Each is executed in addition to
+// the generated probes and must not be mutated by the plugin.
+func WithInputs(cases ...map[string]interface{}) Option {
+	return func(c *config) {
+		c.cases = append(c.cases, cases...)
+	}
+}
+
+// WithRuntime sets the runtime passed to Execute. A new runtime is created for
+// every call. The default runtime is nil.
+func WithRuntime(newRuntime func() interface{}) Option {
+	return func(c *config) {
+		c.newRuntime = newRuntime
+	}
+}
+
+// WithSchema overrides the schema used for probing and output checks.
+// By default the schema is looked up in registry.Builtin() by node type.
+func WithSchema(schema registry.Schema) Option {
+	return func(c *config) {
+		c.schema = &schema
+	}
+}
+
+// RunConformance checks the NodeExecutor contract for p:
+//   - Execute never panics, including on nil, missing or mistyped inputs
+//   - Execute always returns a non-nil output map
+//   - Execute never mutates its input map or values nested in it
+//   - output keys are declared in the plugin's schema ("error" is always allowed)
+func RunConformance(t *testing.T, p plugin.NodeExecutor, opts ...Option) {
+	t.Helper()
+
+	cfg := &config{newRuntime: func() interface{} { return nil }}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	schema := cfg.schema
+	if schema == nil {
+		if node, ok := registry.Builtin().Get(nodeType(p)); ok {
+			schema = &node.Schema
+		} else {
+			schema = &registry.Schema{}
+		}
+	}
+
+	check := func(t *testing.T, inputs map[string]interface{}) {
+		t.Helper()
+
+		var before map[string]interface{}
+		if inputs != nil {
+			before = deepCopy(inputs).(map[string]interface{})
+		}
+
+		out, recovered, stack := execute(p, inputs, cfg.newRuntime())
+		if recovered != nil {
+			t.Fatalf("Execute panicked with inputs %v: %v\n%s", inputs, recovered, stack)
+		}
+		if out == nil {
+			t.Fatalf("Execute returned a nil output map for inputs %v", inputs)
+		}
+		if inputs != nil && !reflect.DeepEqual(before, inputs) {
+			t.Errorf("Execute mutated its inputs:\nbefore: %v\nafter:  %v", before, inputs)
+		}
+		if undeclared := undeclaredOutputs(schema, out); len(undeclared) > 0 {
+			t.Errorf("Execute returned undeclared outputs %v for inputs %v", undeclared, inputs)
+		}
+	}
+
+	t.Run("nil inputs", func(t *testing.T) {
+		check(t, nil)
+	})
+	t.Run("empty inputs", func(t *testing.T) {
+		check(t, map[string]interface{}{})
+	})
+	for i, value := range probeValues() {
+		inputs := make(map[string]interface{}, len(schema.Inputs))
+		for _, port := range schema.Inputs {
+			inputs[port.Name] = deepCopy(value)
+		}
+		t.Run(fmt.Sprintf("probe %d", i), func(t *testing.T) {
+			check(t, inputs)
+		})
+	}
+	for i, inputs := range cfg.cases {
+		inputs := inputs
+		t.Run(fmt.Sprintf("case %d", i), func(t *testing.T) {
+			check(t, inputs)
+		})
+	}
+}
+
+// execute runs p.Execute, converting a panic into a return value.
+func execute(p plugin.NodeExecutor, inputs map[string]interface{}, runtime interface{}) (out map[string]interface{}, recovered interface{}, stack []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			recovered = r
+			stack = debug.Stack()
+		}
+	}()
+	return p.Execute(inputs, runtime), nil, nil
+}
+
+// probeValues returns values of every JSON type, including edge cases such as
+// negative numbers, empty collections and a list holding an empty string
+// (an empty path, where a list of paths is expected), used to fill all
+// inputs of a plugin.
+func probeValues() []interface{} {
+	return []interface{}{
+		nil,
+		"",
+		"a.b",
+		0.0,
+		-1.0,
+		2.5,
+		true,
+		[]interface{}{},
+		[]interface{}{""},
+		[]interface{}{3.0, "x", nil, true, map[string]interface{}{"k": 1.0}, []interface{}{1.0}},
+		map[string]interface{}{},
+		map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1.0, 2.0}}, "k": "v"},
+	}
+}
+
+// undeclaredOutputs returns output keys missing from schema.Outputs.
+// Plugins without documented outputs are not checked.
+func undeclaredOutputs(schema *registry.Schema, out map[string]interface{}) []string {
+	if len(schema.Outputs) == 0 {
+		return nil
+	}
+	declared := make(map[string]bool, len(schema.Outputs))
+	for _, port := range schema.Outputs {
+		declared[port.Name] = true
+	}
+
+	var undeclared []string
+	for key := range out {
+		if key != "error" && !declared[key] {
+			undeclared = append(undeclared, key)
+		}
+	}
+	sort.Strings(undeclared)
+	return undeclared
+}
+
+// nodeType reads the NodeType field of a plugin struct.
+func nodeType(p plugin.NodeExecutor) string {
+	v := reflect.ValueOf(p)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f := v.FieldByName("NodeType")
+	if !f.IsValid() || f.Kind() != reflect.String {
+		return ""
+	}
+	return f.String()
+}
+
+// deepCopy copies maps and slices recursively so later mutation can be detected.
+func deepCopy(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			result[k] = deepCopy(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(val))
+		for i, item := range val {
+			result[i] = deepCopy(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,122 @@
+package plugintest
+
+import (
+	"sync"
+	"time"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+)
+
+// Runtime is a plugin.Runtime for tests. It embeds the real runtime, so it
+// satisfies the store and event interfaces plugins look for, and adds a
+// capturing logger and a fake clock.
+type Runtime struct {
+	*plugin.Runtime
+	Log   *Logger
+	Clock *Clock
+}
+
+// NewRuntime creates a test runtime with an empty in-memory store, an event
+// bus, a capturing logger and a clock stopped at 2024-01-01T00:00:00Z.
+func NewRuntime() *Runtime {
+	log := &Logger{}
+	return &Runtime{
+		Runtime: &plugin.Runtime{
+			Store:   make(map[string]interface{}),
+			Context: make(map[string]interface{}),
+			Logger:  log,
+			Events:  plugin.NewEventBus(),
+		},
+		Log:   log,
+		Clock: NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+// Now returns the fake clock's current time.
+// Plugins that need the time should look for this method on their runtime.
+func (r *Runtime) Now() time.Time {
+	return r.Clock.Now()
+}
+
+// Entry is one captured log message.
+type Entry struct {
+	Level   string // "info", "error" or "debug"
+	Message string
+}
+
+// Logger implements plugin.Logger by recording every message.
+// It is safe for concurrent use.
+type Logger struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+func (l *Logger) Info(msg string)  { l.add("info", msg) }
+func (l *Logger) Error(msg string) { l.add("error", msg) }
+func (l *Logger) Debug(msg string) { l.add("debug", msg) }
+
+func (l *Logger) add(level, msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, Entry{Level: level, Message: msg})
+}
+
+// Entries returns a copy of all captured messages in order.
+func (l *Logger) Entries() []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Entry(nil), l.entries...)
+}
+
+// Messages returns the captured messages of one level in order.
+func (l *Logger) Messages(level string) []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var messages []string
+	for _, e := range l.entries {
+		if e.Level == level {
+			messages = append(messages, e.Message)
+		}
+	}
+	return messages
+}
+
+// Reset discards all captured messages.
+func (l *Logger) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = nil
+}
+
+// Clock is a fake clock that only moves when told to.
+// It is safe for concurrent use.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock creates a clock stopped at t.
+func NewClock(t time.Time) *Clock {
+	return &Clock{now: t}
+}
+
+// Now returns the current fake time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set moves the clock to t.
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// Advance moves the clock forward by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,205 @@
+// Package predicate builds the element tests shared by the list plugins
+// that select elements (list.filter, list.find and friends): a field
+// compared with a value by an operator, or an expression.
+//
+// Numbers compare by value whatever their Go type, so an int field equals
+// the float64 a JSON document decodes to.
+package predicate
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/metabuilder/workflow-plugins-go/expr"
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// Operators lists the names accepted by Operator.
+var Operators = []string{"eq", "ne", "gt", "gte", "lt", "lte", "contains", "starts_with", "ends_with", "regex", "in", "exists"}
+
+// Func reports whether the element at index matches.
+type Func func(index int, item interface{}) (bool, error)
+
+// Operator returns a test comparing a value with operand. The empty
+// operator means eq.
+//   - eq, ne: equality (deep for lists and dictionaries)
+//   - gt, gte, lt, lte: ordering of two numbers or two strings
+//   - contains: a string contains operand, a list contains it as an element
+//     or a dictionary has it as a key
+//   - starts_with, ends_with: string prefix and suffix
+//   - regex: a string matches the operand pattern
+//   - in: the value is an element of the operand list
+//   - exists: the value is not null
+func Operator(op string, operand interface{}) (func(interface{}) bool, error) {
+	switch op {
+	case "", "eq":
+		return func(v interface{}) bool { return Equal(v, operand) }, nil
+	case "ne":
+		return func(v interface{}) bool { return !Equal(v, operand) }, nil
+	case "gt", "gte", "lt", "lte":
+		return func(v interface{}) bool {
+			cmp, ok := Compare(v, operand)
+			switch {
+			case !ok:
+				return false
+			case op == "gt":
+				return cmp > 0
+			case op == "gte":
+				return cmp >= 0
+			case op == "lt":
+				return cmp < 0
+			default:
+				return cmp <= 0
+			}
+		}, nil
+	case "contains":
+		return func(v interface{}) bool { return contains(v, operand) }, nil
+	case "starts_with", "ends_with":
+		affix, ok := operand.(string)
+		if !ok {
+			return nil, fmt.Errorf("operator %s needs a string value", op)
+		}
+		has := strings.HasPrefix
+		if op == "ends_with" {
+			has = strings.HasSuffix
+		}
+		return func(v interface{}) bool {
+			s, ok := v.(string)
+			return ok && has(s, affix)
+		}, nil
+	case "in":
+		options, ok := operand.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("operator in needs a list value")
+		}
+		return func(v interface{}) bool { return contains(options, v) }, nil
+	case "regex":
+		pattern, _ := operand.(string)
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %v", err)
+		}
+		return func(v interface{}) bool {
+			s, ok := v.(string)
+			return ok && re.MatchString(s)
+		}, nil
+	case "exists":
+		return func(v interface{}) bool { return v != nil }, nil
+	}
+	return nil, fmt.Errorf("unknown operator %q", op)
+}
+
+// Field returns a predicate applying the op test to the value at field of
+// each element. Elements without the field do not match.
+func Field(field paths.Path, op string, operand interface{}) (Func, error) {
+	test, err := Operator(op, operand)
+	if err != nil {
+		return nil, err
+	}
+	return func(_ int, item interface{}) (bool, error) {
+		v, found := paths.Get(item, field)
+		if !found {
+			return false, nil
+		}
+		return test(v), nil
+	}, nil
+}
+
+// Expression returns a predicate evaluating expression for each element,
+// with item and index defined plus the element's fields when it is a
+// dictionary, e.g. "price > 10 && inStock".
+func Expression(expression string, store map[string]interface{}) (Func, error) {
+	x, err := expr.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	return func(i int, item interface{}) (bool, error) {
+		vars := map[string]interface{}{}
+		if obj, ok := item.(map[string]interface{}); ok {
+			for k, v := range obj {
+				vars[k] = v
+			}
+		}
+		vars["item"], vars["index"] = item, float64(i)
+		v, err := x.Eval(vars, store)
+		return expr.Truthy(v), err
+	}, nil
+}
+
+// Equal compares numbers by value and anything else deeply.
+func Equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// Compare orders two numbers or two strings. ok is false for other values.
+func Compare(a, b interface{}) (cmp int, ok bool) {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	sa, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	sb, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(sa, sb), true
+}
+
+// contains reports whether a string contains a substring, a list contains
+// an element or a dictionary contains a key.
+func contains(container, v interface{}) bool {
+	switch c := container.(type) {
+	case string:
+		s, ok := v.(string)
+		return ok && strings.Contains(c, s)
+	case []interface{}:
+		for _, item := range c {
+			if Equal(item, v) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		s, ok := v.(string)
+		if ok {
+			_, exists := c[s]
+			return exists
+		}
+	}
+	return false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
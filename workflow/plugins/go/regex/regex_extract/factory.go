@@ -0,0 +1,7 @@
+// Package regex_extract provides factory for RegexExtract plugin.
+package regex_extract
+
+// Create returns a new RegexExtract instance.
+func Create() *RegexExtract {
+	return NewRegexExtract()
+}
@@ -0,0 +1,124 @@
+// Package regex_extract provides a workflow plugin for extracting regular expression matches.
+package regex_extract
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+// RegexExtract implements the NodeExecutor interface for extracting regular expression matches.
+type RegexExtract struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewRegexExtract creates a new RegexExtract instance.
+func NewRegexExtract() *RegexExtract {
+	return &RegexExtract{
+		NodeType:    "regex.extract",
+		Category:    "regex",
+		Description: "Extract capture groups from a string with a regular expression",
+	}
+}
+
+// Execute runs the plugin logic.
+// Patterns use Go's RE2 syntax; name groups with (?P<name>...) to get them
+// by name. Each match is a dictionary with:
+//   - match: the matched text
+//   - index: position of the match in characters (runes)
+//   - groups: the capture groups in order, null for groups that did not take part
+//   - named: dictionary of the named groups
+//
+// e.g. `(?P<level>\w+): (?P<msg>.*)` on "ERROR: disk full" gives named
+// {"level": "ERROR", "msg": "disk full"}.
+// Inputs:
+//   - string: the string to search
+//   - pattern: the regular expression
+//   - all: (optional) return every match instead of the first (default: false)
+//   - limit: (optional) maximum number of matches with all; 0 or less means no limit (default: no limit)
+//
+// Returns:
+//   - result: the first match or null, or the list of matches with all
+//   - count: number of matches returned
+//   - error: set if string or pattern is missing or the pattern is invalid
+func (p *RegexExtract) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	all, _ := inputs["all"].(bool)
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		var empty interface{}
+		if all {
+			empty = []interface{}{}
+		}
+		return map[string]interface{}{"result": empty, "count": 0, "error": fmt.Sprintf(format, args...)}
+	}
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return fail("string is required")
+	}
+	pattern, ok := inputs["pattern"].(string)
+	if !ok {
+		return fail("pattern is required")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fail("invalid pattern: %v", err)
+	}
+
+	n := 1
+	if all {
+		n = -1
+		if l, ok := toInt(inputs["limit"]); ok && l > 0 {
+			n = l
+		}
+	}
+	found := re.FindAllStringSubmatchIndex(str, n)
+
+	matches := make([]interface{}, len(found))
+	for i, loc := range found {
+		matches[i] = describe(re, str, loc)
+	}
+	if !all {
+		if len(matches) == 0 {
+			return map[string]interface{}{"result": nil, "count": 0}
+		}
+		return map[string]interface{}{"result": matches[0], "count": 1}
+	}
+	return map[string]interface{}{"result": matches, "count": len(matches)}
+}
+
+// describe builds the dictionary for one match from its submatch indices.
+func describe(re *regexp.Regexp, str string, loc []int) map[string]interface{} {
+	names := re.SubexpNames()
+	groups := make([]interface{}, 0, len(names)-1)
+	named := map[string]interface{}{}
+	for g := 1; g < len(names); g++ {
+		var v interface{}
+		if loc[2*g] >= 0 {
+			v = str[loc[2*g]:loc[2*g+1]]
+		}
+		groups = append(groups, v)
+		if names[g] != "" {
+			named[names[g]] = v
+		}
+	}
+	return map[string]interface{}{
+		"match":  str[loc[0]:loc[1]],
+		"index":  utf8.RuneCountInString(str[:loc[0]]),
+		"groups": groups,
+		"named":  named,
+	}
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,7 @@
+// Package regex_split provides factory for RegexSplit plugin.
+package regex_split
+
+// Create returns a new RegexSplit instance.
+func Create() *RegexSplit {
+	return NewRegexSplit()
+}
@@ -0,0 +1,105 @@
+// Package regex_split provides a workflow plugin for splitting strings on a regular expression.
+package regex_split
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// RegexSplit implements the NodeExecutor interface for splitting strings on a regular expression.
+type RegexSplit struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewRegexSplit creates a new RegexSplit instance.
+func NewRegexSplit() *RegexSplit {
+	return &RegexSplit{
+		NodeType:    "regex.split",
+		Category:    "regex",
+		Description: "Split a string on a regular expression",
+	}
+}
+
+// Execute runs the plugin logic.
+// Splits at every match of pattern, e.g. `\s+` for any run of whitespace
+// or `\s*[,;]\s*` for commas and semicolons with optional spacing. With a
+// limit, the last part holds the unsplit remainder.
+// Inputs:
+//   - string: the string to split
+//   - pattern: the regular expression separating parts
+//   - limit: (optional) maximum number of parts (default: no limit)
+//   - drop_empty: (optional) leave out empty parts, e.g. from leading separators; they do not count toward limit (default: false)
+//
+// Returns:
+//   - result: list of parts
+//   - count: number of parts
+//   - error: set if string or pattern is missing or the pattern is invalid
+func (p *RegexSplit) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": []interface{}{}, "count": 0, "error": fmt.Sprintf(format, args...)}
+	}
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return fail("string is required")
+	}
+	pattern, ok := inputs["pattern"].(string)
+	if !ok {
+		return fail("pattern is required")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fail("invalid pattern: %v", err)
+	}
+
+	limit := -1
+	if n, ok := toInt(inputs["limit"]); ok && n > 0 {
+		limit = n
+	}
+	dropEmpty, _ := inputs["drop_empty"].(bool)
+
+	// Split like regexp.Split, but skip empty parts before counting them
+	// toward the limit, so leading separators do not use it up
+	result := []interface{}{}
+	add := func(part string) {
+		if !dropEmpty || part != "" {
+			result = append(result, part)
+		}
+	}
+	if str == "" {
+		add("")
+		return map[string]interface{}{"result": result, "count": len(result)}
+	}
+	start, end := 0, 0
+	for _, m := range re.FindAllStringIndex(str, -1) {
+		// An empty match at the very start separates nothing
+		empty := m[1] == 0 || dropEmpty && m[0] == start
+		if !empty && limit > 0 && len(result) == limit-1 {
+			break
+		}
+		end = m[0]
+		if !empty {
+			add(str[start:end])
+		}
+		start = m[1]
+	}
+	if end != len(str) {
+		add(str[start:])
+	}
+
+	return map[string]interface{}{"result": result, "count": len(result)}
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,26 @@
+package registry
+
+import (
+	plugin "github.com/metabuilder/workflow-plugins-go"
+)
+
+// builtin pairs a plugin shipped with this module with its documented schema.
+type builtin struct {
+	executor plugin.NodeExecutor
+	schema   Schema
+}
+
+// Builtin returns a new registry populated with every plugin in this module.
+func Builtin() *Registry {
+	r := New()
+	for _, c := range builtinCategories {
+		r.SetCategoryInfo(c)
+	}
+	for _, b := range builtins {
+		if _, err := r.Register(b.executor, b.schema); err != nil {
+			// builtins is generated from the source tree, so this is a programming error
+			panic(err)
+		}
+	}
+	return r
+}
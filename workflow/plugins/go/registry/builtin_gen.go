@@ -0,0 +1,1668 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package registry
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/charset/charset_convert"
+	"github.com/metabuilder/workflow-plugins-go/convert/convert_parse_json"
+	"github.com/metabuilder/workflow-plugins-go/convert/convert_to_boolean"
+	"github.com/metabuilder/workflow-plugins-go/convert/convert_to_json"
+	"github.com/metabuilder/workflow-plugins-go/convert/convert_to_number"
+	"github.com/metabuilder/workflow-plugins-go/convert/convert_to_string"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_apply_patch"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_deep_equal"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_delete"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_diff"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_filter"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_from_entries"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_get"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_has_key"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_invert"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_keys"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_merge"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_omit"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_pick"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_prune"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_query"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_set"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_set_many"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_to_entries"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_transform_keys"
+	"github.com/metabuilder/workflow-plugins-go/dict/dict_values"
+	"github.com/metabuilder/workflow-plugins-go/eval/eval_expression"
+	"github.com/metabuilder/workflow-plugins-go/event/event_publish"
+	"github.com/metabuilder/workflow-plugins-go/event/event_subscribe"
+	"github.com/metabuilder/workflow-plugins-go/list/list_aggregate"
+	"github.com/metabuilder/workflow-plugins-go/list/list_append"
+	"github.com/metabuilder/workflow-plugins-go/list/list_compact"
+	"github.com/metabuilder/workflow-plugins-go/list/list_concat"
+	"github.com/metabuilder/workflow-plugins-go/list/list_contains"
+	"github.com/metabuilder/workflow-plugins-go/list/list_count_by"
+	"github.com/metabuilder/workflow-plugins-go/list/list_difference"
+	"github.com/metabuilder/workflow-plugins-go/list/list_drop_while"
+	"github.com/metabuilder/workflow-plugins-go/list/list_filter"
+	"github.com/metabuilder/workflow-plugins-go/list/list_find"
+	"github.com/metabuilder/workflow-plugins-go/list/list_find_all"
+	"github.com/metabuilder/workflow-plugins-go/list/list_index_of"
+	"github.com/metabuilder/workflow-plugins-go/list/list_insert"
+	"github.com/metabuilder/workflow-plugins-go/list/list_interleave"
+	"github.com/metabuilder/workflow-plugins-go/list/list_intersect"
+	"github.com/metabuilder/workflow-plugins-go/list/list_join"
+	"github.com/metabuilder/workflow-plugins-go/list/list_length"
+	"github.com/metabuilder/workflow-plugins-go/list/list_range"
+	"github.com/metabuilder/workflow-plugins-go/list/list_reduce"
+	"github.com/metabuilder/workflow-plugins-go/list/list_remove_at"
+	"github.com/metabuilder/workflow-plugins-go/list/list_reverse"
+	"github.com/metabuilder/workflow-plugins-go/list/list_rotate"
+	"github.com/metabuilder/workflow-plugins-go/list/list_sample"
+	"github.com/metabuilder/workflow-plugins-go/list/list_shuffle"
+	"github.com/metabuilder/workflow-plugins-go/list/list_slice"
+	"github.com/metabuilder/workflow-plugins-go/list/list_sort"
+	"github.com/metabuilder/workflow-plugins-go/list/list_take_while"
+	"github.com/metabuilder/workflow-plugins-go/list/list_to_dict"
+	"github.com/metabuilder/workflow-plugins-go/list/list_union"
+	"github.com/metabuilder/workflow-plugins-go/list/list_unique"
+	"github.com/metabuilder/workflow-plugins-go/logic/logic_and"
+	"github.com/metabuilder/workflow-plugins-go/logic/logic_equals"
+	"github.com/metabuilder/workflow-plugins-go/logic/logic_gt"
+	"github.com/metabuilder/workflow-plugins-go/logic/logic_lt"
+	"github.com/metabuilder/workflow-plugins-go/logic/logic_not"
+	"github.com/metabuilder/workflow-plugins-go/logic/logic_or"
+	"github.com/metabuilder/workflow-plugins-go/math/math_abs"
+	"github.com/metabuilder/workflow-plugins-go/math/math_add"
+	"github.com/metabuilder/workflow-plugins-go/math/math_divide"
+	"github.com/metabuilder/workflow-plugins-go/math/math_mod"
+	"github.com/metabuilder/workflow-plugins-go/math/math_multiply"
+	"github.com/metabuilder/workflow-plugins-go/math/math_power"
+	"github.com/metabuilder/workflow-plugins-go/math/math_round"
+	"github.com/metabuilder/workflow-plugins-go/math/math_sqrt"
+	"github.com/metabuilder/workflow-plugins-go/math/math_stats"
+	"github.com/metabuilder/workflow-plugins-go/math/math_subtract"
+	"github.com/metabuilder/workflow-plugins-go/regex/regex_extract"
+	"github.com/metabuilder/workflow-plugins-go/regex/regex_split"
+	"github.com/metabuilder/workflow-plugins-go/string/string_case_convert"
+	"github.com/metabuilder/workflow-plugins-go/string/string_concat"
+	"github.com/metabuilder/workflow-plugins-go/string/string_contains"
+	"github.com/metabuilder/workflow-plugins-go/string/string_ends_with"
+	"github.com/metabuilder/workflow-plugins-go/string/string_escape"
+	"github.com/metabuilder/workflow-plugins-go/string/string_format"
+	"github.com/metabuilder/workflow-plugins-go/string/string_index_of"
+	"github.com/metabuilder/workflow-plugins-go/string/string_interpolate"
+	"github.com/metabuilder/workflow-plugins-go/string/string_length"
+	"github.com/metabuilder/workflow-plugins-go/string/string_lower"
+	"github.com/metabuilder/workflow-plugins-go/string/string_mask"
+	"github.com/metabuilder/workflow-plugins-go/string/string_normalize"
+	"github.com/metabuilder/workflow-plugins-go/string/string_pad"
+	"github.com/metabuilder/workflow-plugins-go/string/string_repeat"
+	"github.com/metabuilder/workflow-plugins-go/string/string_replace"
+	"github.com/metabuilder/workflow-plugins-go/string/string_replace_many"
+	"github.com/metabuilder/workflow-plugins-go/string/string_reverse"
+	"github.com/metabuilder/workflow-plugins-go/string/string_similarity"
+	"github.com/metabuilder/workflow-plugins-go/string/string_split"
+	"github.com/metabuilder/workflow-plugins-go/string/string_starts_with"
+	"github.com/metabuilder/workflow-plugins-go/string/string_stats"
+	"github.com/metabuilder/workflow-plugins-go/string/string_strip_html"
+	"github.com/metabuilder/workflow-plugins-go/string/string_substring"
+	"github.com/metabuilder/workflow-plugins-go/string/string_template"
+	"github.com/metabuilder/workflow-plugins-go/string/string_trim"
+	"github.com/metabuilder/workflow-plugins-go/string/string_truncate"
+	"github.com/metabuilder/workflow-plugins-go/string/string_unescape"
+	"github.com/metabuilder/workflow-plugins-go/string/string_upper"
+	"github.com/metabuilder/workflow-plugins-go/var/var_delete"
+	"github.com/metabuilder/workflow-plugins-go/var/var_get"
+	"github.com/metabuilder/workflow-plugins-go/var/var_set"
+)
+
+// builtins lists every plugin shipped with this module.
+var builtins = []builtin{
+	{
+		executor: charset_convert.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "data", Description: "the bytes or string to convert"},
+				{Name: "from", Description: "encoding of data, or \"auto\" to detect it (default: \"auto\")", Optional: true},
+				{Name: "to", Description: "encoding of the result (default: \"utf-8\")", Optional: true},
+				{Name: "base64_input", Description: "data is base64-encoded (default: false)", Optional: true},
+				{Name: "base64_output", Description: "base64-encode the result (default: false)", Optional: true},
+				{Name: "strict", Description: "fail on bytes that cannot be decoded or characters that cannot be encoded, instead of replacing them with U+FFFD or \"?\" (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the converted text"},
+				{Name: "encoding", Description: "the encoding data was read as"},
+				{Name: "replaced", Description: "number of characters that could not be encoded in to and were replaced"},
+				{Name: "error", Description: "set if data is missing, an encoding is unsupported or, when strict, conversion fails"},
+			},
+		},
+	},
+	{
+		executor: convert_parse_json.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the JSON string to parse"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the parsed value"},
+				{Name: "error", Description: "set if the string is not valid JSON"},
+			},
+		},
+	},
+	{
+		executor: convert_to_boolean.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "value", Description: "the value to convert (\"true\", \"1\" and \"yes\" are truthy strings)"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the boolean value"},
+			},
+		},
+	},
+	{
+		executor: convert_to_json.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "value", Description: "the value to serialize"},
+				{Name: "pretty", Description: "indent the output (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the JSON string"},
+				{Name: "error", Description: "set if the value cannot be serialized"},
+			},
+		},
+	},
+	{
+		executor: convert_to_number.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "value", Description: "the value to convert (number, numeric string or boolean)"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the numeric value"},
+				{Name: "error", Description: "set if the value cannot be converted"},
+			},
+		},
+	},
+	{
+		executor: convert_to_string.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "value", Description: "the value to convert (complex types are JSON encoded)"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the string value"},
+			},
+		},
+	},
+	{
+		executor: dict_apply_patch.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to patch"},
+				{Name: "patch", Description: "list of JSON Patch operations, or a merge patch object"},
+				{Name: "atomic", Description: "for JSON Patch, discard every change when an operation fails (default: true)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the patched dictionary, or the original one when an atomic patch failed"},
+				{Name: "success", Description: "whether every operation was applied"},
+				{Name: "operations", Description: "list of {op, path, success, error} for each JSON Patch operation"},
+				{Name: "error", Description: "set when the patch is invalid or an atomic patch failed"},
+			},
+		},
+	},
+	{
+		executor: dict_deep_equal.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "a", Description: "the first dictionary"},
+				{Name: "b", Description: "the second dictionary"},
+				{Name: "ignore_paths", Description: "list of paths to leave out of the comparison, in dict.get syntax with wildcards", Optional: true},
+				{Name: "ignore_list_order", Description: "compare lists as unordered collections (default: false)", Optional: true},
+				{Name: "strict_numbers", Description: "treat int and float values as different types (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "whether the dictionaries are equal"},
+				{Name: "difference", Description: "path of the first difference, or null when equal"},
+				{Name: "error", Description: "set when an ignore path is invalid or empty"},
+			},
+		},
+	},
+	{
+		executor: dict_delete.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to modify"},
+				{Name: "key", Description: "the key to delete (supports dot notation)"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the modified dictionary"},
+				{Name: "deleted", Description: "whether the key was found and deleted"},
+				{Name: "error", Description: "set when the key is not a valid path"},
+			},
+		},
+	},
+	{
+		executor: dict_diff.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "old", Description: "the original dictionary"},
+				{Name: "new", Description: "the updated dictionary"},
+				{Name: "json_patch", Description: "also return the changes as an RFC 6902 JSON Patch (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "added", Description: "list of {path, value} for keys only in new"},
+				{Name: "removed", Description: "list of {path, value} for keys only in old"},
+				{Name: "changed", Description: "list of {path, old, new} for values that differ"},
+				{Name: "equal", Description: "whether the dictionaries are equal"},
+				{Name: "patch", Description: "list of JSON Patch operations turning old into new, when json_patch is set", Optional: true},
+			},
+		},
+	},
+	{
+		executor: dict_filter.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to filter"},
+				{Name: "key_prefix", Description: "keep keys starting with this string", Optional: true},
+				{Name: "key_suffix", Description: "keep keys ending with this string", Optional: true},
+				{Name: "key_pattern", Description: "keep keys matching this regular expression", Optional: true},
+				{Name: "condition", Description: "value condition: equals, not_equals, gt, gte, lt, lte, null, not_null", Optional: true},
+				{Name: "value", Description: "operand for equals, not_equals, gt, gte, lt and lte", Optional: true},
+				{Name: "invert", Description: "keep the entries that do not match instead (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the filtered dictionary"},
+				{Name: "removed", Description: "keys that were filtered out, sorted"},
+				{Name: "error", Description: "set when key_pattern or condition is invalid, or value is missing for a condition that needs it"},
+			},
+		},
+	},
+	{
+		executor: dict_from_entries.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "entries", Description: "list of entries"},
+				{Name: "key_field", Description: "name of the key field in each entry (default: \"key\")", Optional: true},
+				{Name: "value_field", Description: "name of the value field in each entry (default: \"value\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the dictionary"},
+				{Name: "error", Description: "set when an entry has no key"},
+			},
+		},
+	},
+	{
+		executor: dict_get.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to read from"},
+				{Name: "key", Description: "the key to retrieve (supports dot notation)"},
+				{Name: "default", Description: "default value if key not found", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the value at the key or default"},
+				{Name: "found", Description: "whether the key was found"},
+				{Name: "error", Description: "set when the key is not a valid path or contains a wildcard"},
+			},
+		},
+	},
+	{
+		executor: dict_has_key.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to check"},
+				{Name: "key", Description: "the key to look for (supports dot notation)"},
+			},
+			Outputs: []Port{
+				{Name: "found", Description: "whether the key exists"},
+				{Name: "depth", Description: "number of path segments that resolved; equals the path length when found"},
+				{Name: "error", Description: "set when the key is not a valid path or contains a wildcard"},
+			},
+		},
+	},
+	{
+		executor: dict_invert.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to invert"},
+				{Name: "on_collision", Description: "what to do when several keys share a value: \"last\" (default) keeps the last key, \"first\" keeps the first, \"collect\" maps every value to a list of keys", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the inverted dictionary"},
+				{Name: "collisions", Description: "values that more than one key mapped to, sorted"},
+				{Name: "error", Description: "set when on_collision is unknown"},
+			},
+		},
+	},
+	{
+		executor: dict_keys.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to get keys from"},
+				{Name: "sorted", Description: "whether to sort keys alphabetically (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "list of keys"},
+			},
+		},
+	},
+	{
+		executor: dict_merge.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dicts", Description: "list of dictionaries to merge"},
+				{Name: "deep", Description: "perform deep merge for nested objects (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the merged dictionary"},
+			},
+		},
+	},
+	{
+		executor: dict_omit.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to copy"},
+				{Name: "keys", Description: "list of keys to remove"},
+				{Name: "nested", Description: "treat keys as paths like dict.delete, default true; when false keys are matched literally", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the dictionary without the keys"},
+				{Name: "error", Description: "set when a key is empty or not a valid path"},
+			},
+		},
+	},
+	{
+		executor: dict_pick.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to pick from"},
+				{Name: "keys", Description: "list of keys to keep"},
+				{Name: "nested", Description: "treat keys as paths like dict.get, default true; when false keys are matched literally", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the new dictionary"},
+				{Name: "missing", Description: "keys that were not found"},
+				{Name: "error", Description: "set when a key is empty or not a valid path"},
+			},
+		},
+	},
+	{
+		executor: dict_prune.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to prune"},
+				{Name: "remove", Description: "list of kinds to remove: null, empty_string, empty_list, empty_dict (default: all)", Optional: true},
+				{Name: "recursive", Description: "prune nested dictionaries, including those inside lists (default: true)", Optional: true},
+				{Name: "prune_lists", Description: "also drop matching elements from lists (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the pruned dictionary"},
+				{Name: "removed", Description: "paths of the removed values, sorted"},
+				{Name: "error", Description: "set when remove contains an unknown kind"},
+			},
+		},
+	},
+	{
+		executor: dict_query.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to query"},
+				{Name: "path", Description: "a single path to match", Optional: true},
+				{Name: "paths", Description: "list of paths to match, after path", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "list of matched values"},
+				{Name: "matches", Description: "list of {path, value} with the concrete path of each match"},
+				{Name: "count", Description: "number of matches"},
+				{Name: "error", Description: "set when a path is invalid"},
+			},
+		},
+	},
+	{
+		executor: dict_set.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to modify (or nil to create new)"},
+				{Name: "key", Description: "the key to set (supports dot notation)"},
+				{Name: "value", Description: "the value to set"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the modified dictionary"},
+				{Name: "error", Description: "set when the key is not a valid path or cannot be set"},
+			},
+		},
+	},
+	{
+		executor: dict_set_many.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to modify (or nil to create new)"},
+				{Name: "values", Description: "dictionary of path to value, or list of {path, value}"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the modified dictionary"},
+				{Name: "error", Description: "set when a path is invalid or cannot be set"},
+			},
+		},
+	},
+	{
+		executor: dict_to_entries.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to convert"},
+				{Name: "key_field", Description: "name of the key field in each entry (default: \"key\")", Optional: true},
+				{Name: "value_field", Description: "name of the value field in each entry (default: \"value\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "list of entries"},
+			},
+		},
+	},
+	{
+		executor: dict_transform_keys.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to transform"},
+				{Name: "to", Description: "target style: snake, camel, kebab, pascal, constant or title"},
+				{Name: "recursive", Description: "also transform nested dictionaries, including those inside lists (default: true)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the dictionary with renamed keys"},
+				{Name: "collisions", Description: "converted keys that more than one original key mapped to"},
+				{Name: "error", Description: "set when the style is unknown"},
+			},
+		},
+	},
+	{
+		executor: dict_values.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dict", Description: "the dictionary to get values from"},
+				{Name: "sorted_by_key", Description: "return values sorted by their keys (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "list of values"},
+			},
+		},
+	},
+	{
+		executor: eval_expression.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "expression", Description: "the expression to evaluate"},
+				{Name: "vars", Description: "dictionary of variables available to the expression", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the value of the expression"},
+				{Name: "error", Description: "present if the expression could not be parsed or evaluated"},
+			},
+		},
+	},
+	{
+		executor: event_publish.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "topic", Description: "the topic to publish on"},
+				{Name: "payload", Description: "the value delivered to subscribers", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "success", Description: "whether the event was published"},
+				{Name: "delivered", Description: "number of subscribers that were waiting for the event"},
+			},
+		},
+	},
+	{
+		executor: event_subscribe.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "topic", Description: "the topic to wait on"},
+				{Name: "timeout_ms", Description: "how long to wait in milliseconds (default: 30000, 0 = don't wait)", Optional: true},
+				{Name: "replay", Description: "return immediately if the topic was already published (default: true)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "received", Description: "whether an event arrived before the timeout"},
+				{Name: "payload", Description: "the event payload, or nil"},
+			},
+		},
+	},
+	{
+		executor: list_aggregate.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to aggregate"},
+				{Name: "field", Description: "path of the number to use in object elements (supports dot notation)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "dictionary with count, sum, avg, min and max"},
+				{Name: "skipped", Description: "number of elements that were not numbers"},
+				{Name: "error", Description: "set when field is not a valid path"},
+			},
+		},
+	},
+	{
+		executor: list_append.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to extend (or nil to start a new one)"},
+				{Name: "value", Description: "the element to add", Optional: true},
+				{Name: "values", Description: "list of elements to add, after value", Optional: true},
+				{Name: "prepend", Description: "add at the start instead of the end (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the extended list"},
+			},
+		},
+	},
+	{
+		executor: list_compact.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to compact"},
+				{Name: "remove", Description: "list of kinds to remove: null, empty_string, empty_list, empty_dict (default: all)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the list without the removed elements"},
+				{Name: "removed", Description: "number of removed elements"},
+				{Name: "error", Description: "set when remove contains an unknown kind"},
+			},
+		},
+	},
+	{
+		executor: list_concat.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "lists", Description: "list of lists to concatenate"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the concatenated list"},
+			},
+		},
+	},
+	{
+		executor: list_contains.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to search"},
+				{Name: "value", Description: "the value to look for"},
+				{Name: "key", Description: "compare this key of object elements with value", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "whether any element matches"},
+			},
+		},
+	},
+	{
+		executor: list_count_by.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to analyse"},
+				{Name: "key", Description: "path of the field to count in object elements (supports dot notation)", Optional: true},
+				{Name: "expression", Description: "expression whose result is counted instead of key", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "dictionary of value to number of occurrences"},
+				{Name: "most_common", Description: "the most frequent value, or null for an empty list"},
+				{Name: "most_common_count", Description: "occurrences of most_common"},
+				{Name: "distinct", Description: "number of distinct values"},
+				{Name: "error", Description: "set when key or expression is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_difference.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "lists", Description: "the lists to combine"},
+				{Name: "key", Description: "path of the field to compare object elements by instead of as a whole, like list.unique", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "elements only in the first list"},
+				{Name: "error", Description: "set if lists is not a list of lists or key is not a valid path"},
+			},
+		},
+	},
+	{
+		executor: list_drop_while.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to process"},
+				{Name: "key", Description: "path of the field to test in object elements (supports dot notation)", Optional: true},
+				{Name: "op", Description: "eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists", Optional: true},
+				{Name: "value", Description: "the value to compare with", Optional: true},
+				{Name: "expression", Description: "condition evaluated per element instead of key/op/value", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the elements from the first non-matching one on"},
+				{Name: "index", Description: "index of the first element that does not match, or the list length"},
+				{Name: "error", Description: "set when key, op, value or expression is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_filter.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to filter"},
+				{Name: "key", Description: "path of the field to compare in object elements (supports dot notation)", Optional: true},
+				{Name: "op", Description: "eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists", Optional: true},
+				{Name: "value", Description: "the value to compare with; a list for in, a pattern for regex", Optional: true},
+				{Name: "expression", Description: "condition evaluated per element instead of key/op/value", Optional: true},
+				{Name: "invert", Description: "keep the elements that do not match instead (default: false)", Optional: true},
+				{Name: "concurrency", Description: "number of elements tested at the same time, at most the number of CPUs (default: 1)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the matching elements"},
+				{Name: "count", Description: "number of matching elements"},
+				{Name: "error", Description: "set when key, op, pattern or expression is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_find.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to search"},
+				{Name: "key", Description: "path of the field to match in objects (supports dot notation)", Optional: true},
+				{Name: "op", Description: "eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists", Optional: true},
+				{Name: "value", Description: "the value to match (or condition value)"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the first matching element or nil"},
+				{Name: "index", Description: "the index of the match or -1"},
+				{Name: "error", Description: "set when key is not a valid path or op or value is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_find_all.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to search"},
+				{Name: "key", Description: "path of the field to match in objects (supports dot notation)", Optional: true},
+				{Name: "op", Description: "eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists", Optional: true},
+				{Name: "value", Description: "the value to match (or condition value)", Optional: true},
+				{Name: "expression", Description: "condition evaluated per element instead of key/op/value", Optional: true},
+				{Name: "max_results", Description: "stop after this many matches (default: no limit)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the matching elements"},
+				{Name: "indices", Description: "the index of each matching element"},
+				{Name: "count", Description: "number of matching elements"},
+				{Name: "truncated", Description: "true when max_results stopped the search early"},
+				{Name: "error", Description: "set when key, op, value or expression is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_index_of.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to search"},
+				{Name: "value", Description: "the value to find"},
+				{Name: "key", Description: "compare this key of object elements with value", Optional: true},
+				{Name: "all", Description: "also return the indices of every match (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "index", Description: "the index of the first match or -1"},
+				{Name: "indices", Description: "the indices of every match, when all is set", Optional: true},
+			},
+		},
+	},
+	{
+		executor: list_insert.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to insert into"},
+				{Name: "index", Description: "the position to insert at"},
+				{Name: "value", Description: "the element to insert"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the list with the element inserted"},
+				{Name: "error", Description: "set when the index is out of range"},
+			},
+		},
+	},
+	{
+		executor: list_interleave.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "lists", Description: "the lists to interleave"},
+				{Name: "uneven", Description: "\"skip\", \"stop\" or \"pad\" (default: \"skip\")", Optional: true},
+				{Name: "fill", Description: "value used for missing elements with pad (default: null)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the interleaved list"},
+				{Name: "error", Description: "set if lists is not a list of lists or uneven is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_intersect.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "lists", Description: "the lists to combine"},
+				{Name: "key", Description: "path of the field to compare object elements by instead of as a whole, like list.unique", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "elements common to all lists"},
+				{Name: "error", Description: "set if lists is not a list of lists or key is not a valid path"},
+			},
+		},
+	},
+	{
+		executor: list_join.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to join"},
+				{Name: "separator", Description: "string placed between elements (default: \", \")", Optional: true},
+				{Name: "last_separator", Description: "string placed before the last element instead of separator, e.g. \" and \"", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the joined string"},
+				{Name: "error", Description: "set if list is not a list"},
+			},
+		},
+	},
+	{
+		executor: list_length.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to measure"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the number of elements"},
+			},
+		},
+	},
+	{
+		executor: list_range.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "start", Description: "first value, a number (default: 0) or a date", Optional: true},
+				{Name: "end", Description: "where to stop, a number or a date"},
+				{Name: "step", Description: "distance between values; default 1, or -1 when end is below start", Optional: true},
+				{Name: "unit", Description: "for dates: second, minute, hour, day (default), week, month or year; second, minute and hour need a start with a time", Optional: true},
+				{Name: "inclusive", Description: "include end when the sequence reaches it (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the generated list"},
+				{Name: "error", Description: "set when the bounds are invalid, step is zero or the list would exceed 100000 elements"},
+			},
+		},
+	},
+	{
+		executor: list_reduce.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to fold"},
+				{Name: "reducer", Description: "sum, product, min, max, concat or merge; required without expression", Optional: true},
+				{Name: "expression", Description: "custom reducer expression", Optional: true},
+				{Name: "initial", Description: "starting accumulator; defaults to 0 for sum, 1 for product, \"\" or [] for concat, {} for merge, and the first element for min, max and expressions", Optional: true},
+				{Name: "field", Description: "path of the value to fold in object elements (supports dot notation)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the folded value"},
+				{Name: "error", Description: "set when the reducer is unknown or an element has the wrong type"},
+			},
+		},
+	},
+	{
+		executor: list_remove_at.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to remove from"},
+				{Name: "index", Description: "the position of the element to remove", Optional: true},
+				{Name: "value", Description: "the element to remove, when index is not given", Optional: true},
+				{Name: "all", Description: "remove every element equal to value (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the list without the removed elements"},
+				{Name: "removed", Description: "number of elements removed"},
+				{Name: "error", Description: "set when the index is out of range or neither index nor value is given"},
+			},
+		},
+	},
+	{
+		executor: list_reverse.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to reverse"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the reversed list"},
+			},
+		},
+	},
+	{
+		executor: list_rotate.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to rotate"},
+				{Name: "count", Description: "number of positions (default: 1)", Optional: true},
+				{Name: "direction", Description: "\"left\" or \"right\" (default: \"left\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the rotated list"},
+				{Name: "error", Description: "set if direction is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_sample.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to sample from"},
+				{Name: "count", Description: "number of elements to pick (default: 1)", Optional: true},
+				{Name: "replace", Description: "sample with replacement (default: false)", Optional: true},
+				{Name: "seed", Description: "integer seed for the random generator", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the sampled elements"},
+				{Name: "error", Description: "set when count is negative, larger than the list without replacement or above 100000 with replacement"},
+			},
+		},
+	},
+	{
+		executor: list_shuffle.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to shuffle"},
+				{Name: "seed", Description: "integer seed for the random generator", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the shuffled list"},
+			},
+		},
+	},
+	{
+		executor: list_slice.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to slice"},
+				{Name: "start", Description: "start index, negative counts from the end (default: 0)", Optional: true},
+				{Name: "end", Description: "end index (exclusive), negative counts from the end (default: length)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the extracted portion of the list"},
+			},
+		},
+	},
+	{
+		executor: list_sort.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to sort"},
+				{Name: "key", Description: "the key to sort by for objects (supports dot notation)", Optional: true},
+				{Name: "keys", Description: "list of sort keys, most significant first; each is a path or a dictionary with key, descending and nulls", Optional: true},
+				{Name: "descending", Description: "sort in descending order (default: false)", Optional: true},
+				{Name: "nulls", Description: "\"first\" or \"last\" (default: \"last\")", Optional: true},
+				{Name: "collation", Description: "string comparison, \"binary\", \"case_insensitive\" or \"natural\" (case-insensitive, digit runs compared as numbers) (default: \"binary\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the sorted list"},
+				{Name: "error", Description: "set when a key, nulls or collation is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_take_while.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to process"},
+				{Name: "key", Description: "path of the field to test in object elements (supports dot notation)", Optional: true},
+				{Name: "op", Description: "eq (default), ne, gt, gte, lt, lte, contains, starts_with, ends_with, regex, in, exists", Optional: true},
+				{Name: "value", Description: "the value to compare with", Optional: true},
+				{Name: "expression", Description: "condition evaluated per element instead of key/op/value", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the leading matching elements"},
+				{Name: "index", Description: "index of the first element that does not match, or the list length"},
+				{Name: "error", Description: "set when key, op, value or expression is invalid"},
+			},
+		},
+	},
+	{
+		executor: list_to_dict.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list of objects to index"},
+				{Name: "key", Description: "path of the field to index by (supports dot notation)", Optional: true},
+				{Name: "expression", Description: "expression computing the key instead of key", Optional: true},
+				{Name: "on_collision", Description: "what to do when several elements share a key: \"last\" (default) keeps the last element, \"first\" keeps the first, \"collect\" maps every key to a list of elements, \"error\" fails", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the dictionary of key to element"},
+				{Name: "collisions", Description: "keys that more than one element had, sorted"},
+				{Name: "skipped", Description: "number of elements without the key"},
+				{Name: "error", Description: "set when key, expression or on_collision is invalid, or on a collision with \"error\""},
+			},
+		},
+	},
+	{
+		executor: list_union.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "lists", Description: "the lists to combine"},
+				{Name: "key", Description: "path of the field to compare object elements by instead of as a whole, like list.unique", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "distinct elements of all lists"},
+				{Name: "error", Description: "set if lists is not a list of lists or key is not a valid path"},
+			},
+		},
+	},
+	{
+		executor: list_unique.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "list", Description: "the list to deduplicate"},
+				{Name: "key", Description: "path of the field to use for uniqueness in objects (supports dot notation)", Optional: true},
+				{Name: "keys", Description: "list of paths that together identify objects, instead of key", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the list with duplicates removed"},
+				{Name: "error", Description: "set when a key is not a valid path"},
+			},
+		},
+	},
+	{
+		executor: logic_and.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "values", Description: "list of values to combine"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "true if every value is truthy"},
+			},
+		},
+	},
+	{
+		executor: logic_equals.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "a", Description: "the first value"},
+				{Name: "b", Description: "the second value"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "whether the values are deeply equal"},
+			},
+		},
+	},
+	{
+		executor: logic_gt.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "a", Description: "the first number"},
+				{Name: "b", Description: "the second number"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "whether a is greater than b"},
+			},
+		},
+	},
+	{
+		executor: logic_lt.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "a", Description: "the first number"},
+				{Name: "b", Description: "the second number"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "whether a is less than b"},
+			},
+		},
+	},
+	{
+		executor: logic_not.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "value", Description: "the value to negate"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "true if the value is falsy"},
+			},
+		},
+	},
+	{
+		executor: logic_or.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "values", Description: "list of values to combine"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "true if any value is truthy"},
+			},
+		},
+	},
+	{
+		executor: math_abs.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "value", Description: "the number"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the absolute value"},
+				{Name: "sign", Description: "-1, 0 or 1"},
+				{Name: "error", Description: "set if value is missing or not a number"},
+			},
+		},
+	},
+	{
+		executor: math_add.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "numbers", Description: "list of numbers to add"},
+				{Name: "precision", Description: "\"float\", or \"decimal\" for exact decimal arithmetic on numbers and numeric strings, with the result as a string (default: \"float\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the sum"},
+				{Name: "error", Description: "set if numbers is not a list, or in decimal precision an element is not a number or a result needs more than 4000 digits"},
+			},
+		},
+	},
+	{
+		executor: math_divide.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "numbers", Description: "list of numbers, the first is divided by each subsequent number"},
+				{Name: "precision", Description: "\"float\", or \"decimal\" for exact decimal arithmetic on numbers and numeric strings, with the result as a string (default: \"float\")", Optional: true},
+				{Name: "scale", Description: "in decimal precision, decimal places kept when the quotient does not terminate, such as 1 / 3 (default: 20)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the quotient"},
+				{Name: "error", Description: "set on division by zero, if fewer than two numbers are given or, in decimal precision, if an element is not a number or a result needs more than 4000 digits"},
+			},
+		},
+	},
+	{
+		executor: math_mod.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "dividend", Description: "the number to divide"},
+				{Name: "divisor", Description: "the number to divide by"},
+				{Name: "mode", Description: "\"floored\" or \"truncated\" (default: \"floored\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "quotient", Description: "the whole number of times divisor goes into dividend"},
+				{Name: "remainder", Description: "what is left over"},
+				{Name: "error", Description: "set if an input is missing, divisor is zero, mode is unknown or the quotient overflows"},
+			},
+		},
+	},
+	{
+		executor: math_multiply.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "numbers", Description: "list of numbers to multiply"},
+				{Name: "precision", Description: "\"float\", or \"decimal\" for exact decimal arithmetic on numbers and numeric strings, with the result as a string (default: \"float\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the product"},
+				{Name: "error", Description: "set if numbers is not a non-empty list, or in decimal precision an element is not a number or a result needs more than 4000 digits"},
+			},
+		},
+	},
+	{
+		executor: math_power.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "base", Description: "the number to raise"},
+				{Name: "exponent", Description: "the power to raise it to"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "base raised to exponent"},
+				{Name: "error", Description: "set if an input is missing, the result is not a real number (a negative base with a fractional exponent), zero is raised to a negative power or the result overflows"},
+			},
+		},
+	},
+	{
+		executor: math_round.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "value", Description: "the number to round"},
+				{Name: "precision", Description: "decimal places to keep, up to 308; negative values round to tens, hundreds and so on (default: 0)", Optional: true},
+				{Name: "mode", Description: "rounding mode (default: \"half_up\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the rounded number"},
+				{Name: "formatted", Description: "the rounded number as a string with exactly precision decimal places, e.g. \"1.50\""},
+				{Name: "error", Description: "set if value is missing or not finite, or precision or mode is invalid"},
+			},
+		},
+	},
+	{
+		executor: math_sqrt.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "value", Description: "the number to take the root of"},
+				{Name: "root", Description: "which root to take, a positive integer (default: 2)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the root"},
+				{Name: "error", Description: "set if value is missing, root is not a positive integer or an even root of a negative number is asked for"},
+			},
+		},
+	},
+	{
+		executor: math_stats.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "numbers", Description: "list of numbers"},
+				{Name: "percentiles", Description: "list of percentiles between 0 and 100 (default: [])", Optional: true},
+				{Name: "sample", Description: "compute sample rather than population variance and standard deviation (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "count", Description: "number of numbers"},
+				{Name: "mean", Description: "the arithmetic mean"},
+				{Name: "median", Description: "the middle value"},
+				{Name: "min", Description: "the smallest number"},
+				{Name: "max", Description: "the largest number"},
+				{Name: "variance", Description: "the variance"},
+				{Name: "stddev", Description: "the standard deviation"},
+				{Name: "percentiles", Description: "dictionary of the requested percentiles"},
+				{Name: "skipped", Description: "number of elements that were not numbers"},
+				{Name: "error", Description: "set if numbers is not a list or a percentile is out of range"},
+			},
+		},
+	},
+	{
+		executor: math_subtract.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "numbers", Description: "list of numbers, subsequent numbers are subtracted from the first"},
+				{Name: "precision", Description: "\"float\", or \"decimal\" for exact decimal arithmetic on numbers and numeric strings, with the result as a string (default: \"float\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the difference"},
+				{Name: "error", Description: "set if numbers is not a non-empty list, or in decimal precision an element is not a number or a result needs more than 4000 digits"},
+			},
+		},
+	},
+	{
+		executor: regex_extract.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to search"},
+				{Name: "pattern", Description: "the regular expression"},
+				{Name: "all", Description: "return every match instead of the first (default: false)", Optional: true},
+				{Name: "limit", Description: "maximum number of matches with all; 0 or less means no limit (default: no limit)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the first match or null, or the list of matches with all"},
+				{Name: "count", Description: "number of matches returned"},
+				{Name: "error", Description: "set if string or pattern is missing or the pattern is invalid"},
+			},
+		},
+	},
+	{
+		executor: regex_split.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to split"},
+				{Name: "pattern", Description: "the regular expression separating parts"},
+				{Name: "limit", Description: "maximum number of parts (default: no limit)", Optional: true},
+				{Name: "drop_empty", Description: "leave out empty parts, e.g. from leading separators; they do not count toward limit (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "list of parts"},
+				{Name: "count", Description: "number of parts"},
+				{Name: "error", Description: "set if string or pattern is missing or the pattern is invalid"},
+			},
+		},
+	},
+	{
+		executor: string_case_convert.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the identifier to convert"},
+				{Name: "to", Description: "target style: snake, camel, kebab, pascal, constant or title"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the converted identifier"},
+				{Name: "words", Description: "the words the identifier was split into"},
+				{Name: "error", Description: "set if string is missing or to is unknown"},
+			},
+		},
+	},
+	{
+		executor: string_concat.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "strings", Description: "list of values to concatenate"},
+				{Name: "separator", Description: "string placed between values (default: \"\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the concatenated string"},
+				{Name: "error", Description: "set if strings is not a list"},
+			},
+		},
+	},
+	{
+		executor: string_contains.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to check"},
+				{Name: "value", Description: "the substring to look for"},
+				{Name: "ignore_case", Description: "compare case-insensitively (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "true if the check passes"},
+				{Name: "error", Description: "set if string or value is missing"},
+			},
+		},
+	},
+	{
+		executor: string_ends_with.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to check"},
+				{Name: "value", Description: "the suffix to check for"},
+				{Name: "ignore_case", Description: "compare case-insensitively (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "true if the check passes"},
+				{Name: "error", Description: "set if string or value is missing"},
+			},
+		},
+	},
+	{
+		executor: string_escape.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to escape"},
+				{Name: "mode", Description: "html, xml, json, url, url_path or shell"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the escaped string"},
+				{Name: "error", Description: "set if string is missing or mode is unknown"},
+			},
+		},
+	},
+	{
+		executor: string_format.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "format", Description: "the template"},
+				{Name: "args", Description: "list of arguments, used in order", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the formatted string"},
+				{Name: "missing", Description: "number of verbs that had no argument"},
+				{Name: "unused", Description: "number of arguments that were not used"},
+				{Name: "error", Description: "set if format is missing"},
+			},
+		},
+	},
+	{
+		executor: string_index_of.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to search"},
+				{Name: "value", Description: "the substring to look for"},
+				{Name: "ignore_case", Description: "compare case-insensitively (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "index", Description: "rune index of the first occurrence, or -1"},
+				{Name: "last_index", Description: "rune index of the last occurrence, or -1"},
+				{Name: "error", Description: "set if string or value is missing"},
+			},
+		},
+	},
+	{
+		executor: string_interpolate.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the text containing placeholders"},
+				{Name: "values", Description: "dictionary of values, taking precedence over the store", Optional: true},
+				{Name: "strict", Description: "fail if a placeholder cannot be resolved, instead of leaving it in place (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the interpolated string"},
+				{Name: "unresolved", Description: "list of placeholder names that could not be resolved"},
+				{Name: "error", Description: "set if string is missing or, when strict, a placeholder is unresolved"},
+			},
+		},
+	},
+	{
+		executor: string_length.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to measure"},
+				{Name: "unit", Description: "\"bytes\" (UTF-8), \"runes\" (code points) or \"graphemes\" (user-perceived characters) (default: \"runes\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the length in the chosen unit"},
+				{Name: "error", Description: "set if string is missing or unit is invalid"},
+			},
+		},
+	},
+	{
+		executor: string_lower.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to convert"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the lowercased string"},
+			},
+		},
+	},
+	{
+		executor: string_mask.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to mask"},
+				{Name: "keep_first", Description: "runes to leave visible at the start (default: 0)", Optional: true},
+				{Name: "keep_last", Description: "runes to leave visible at the end (default: 0)", Optional: true},
+				{Name: "mask_char", Description: "replacement for each masked character (default: \"*\")", Optional: true},
+				{Name: "patterns", Description: "list of \"email\", \"credit_card\" and \"phone\" to mask within the string", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the masked string"},
+				{Name: "count", Description: "number of masked values"},
+				{Name: "error", Description: "set if string is missing or a pattern is unknown"},
+			},
+		},
+	},
+	{
+		executor: string_normalize.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to normalize"},
+				{Name: "form", Description: "NFC, NFD, NFKC or NFKD (default: NFC)", Optional: true},
+				{Name: "strip_marks", Description: "remove diacritics, e.g. \"Crème\" to \"Creme\" (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the normalized string"},
+				{Name: "error", Description: "set if string is missing or form is unknown"},
+			},
+		},
+	},
+	{
+		executor: string_pad.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to pad"},
+				{Name: "length", Description: "the length to pad to, at most 10485760"},
+				{Name: "side", Description: "where to add padding: \"left\", \"right\" or \"center\" (default: \"right\")", Optional: true},
+				{Name: "pad", Description: "the padding string (default: \" \")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the padded string"},
+				{Name: "error", Description: "set if string or length is missing, length is too large, side is invalid or pad is empty"},
+			},
+		},
+	},
+	{
+		executor: string_repeat.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to repeat"},
+				{Name: "count", Description: "number of repetitions"},
+				{Name: "separator", Description: "string placed between repetitions (default: \"\")", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the repeated string"},
+				{Name: "error", Description: "set if string or count is missing, count is negative or the result would be too long"},
+			},
+		},
+	},
+	{
+		executor: string_replace.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to search"},
+				{Name: "old", Description: "the substring to replace"},
+				{Name: "new", Description: "the replacement"},
+				{Name: "count", Description: "maximum number of replacements (default: -1, all)", Optional: true},
+				{Name: "ignore_case", Description: "match old case-insensitively (default: false)", Optional: true},
+				{Name: "whole_word", Description: "only replace whole words (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the string with replacements applied"},
+			},
+		},
+	},
+	{
+		executor: string_replace_many.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to search"},
+				{Name: "replacements", Description: "dictionary of old to new strings, or a list of [old, new] pairs applied in order of priority"},
+				{Name: "ignore_case", Description: "match case-insensitively (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the string with replacements applied"},
+				{Name: "count", Description: "number of replacements made"},
+				{Name: "error", Description: "set if string or replacements is missing or invalid"},
+			},
+		},
+	},
+	{
+		executor: string_reverse.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to reverse"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the reversed string"},
+				{Name: "error", Description: "set if string is missing"},
+			},
+		},
+	},
+	{
+		executor: string_similarity.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "a", Description: "the first string"},
+				{Name: "b", Description: "the second string"},
+				{Name: "ignore_case", Description: "compare case-insensitively (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "distance", Description: "the Levenshtein edit distance"},
+				{Name: "ratio", Description: "similarity from 0 to 1 based on the edit distance"},
+				{Name: "jaro_winkler", Description: "Jaro-Winkler similarity from 0 to 1"},
+				{Name: "error", Description: "set if a or b is missing or too long"},
+			},
+		},
+	},
+	{
+		executor: string_split.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to split"},
+				{Name: "separator", Description: "the separator, empty splits into characters (default: \"\")", Optional: true},
+				{Name: "separators", Description: "list of separators to split at, instead of separator", Optional: true},
+				{Name: "limit", Description: "maximum number of parts (default: no limit)", Optional: true},
+				{Name: "drop_empty", Description: "leave out empty parts; they do not count toward limit (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "list of parts"},
+			},
+		},
+	},
+	{
+		executor: string_starts_with.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to check"},
+				{Name: "value", Description: "the prefix to check for"},
+				{Name: "ignore_case", Description: "compare case-insensitively (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "true if the check passes"},
+				{Name: "error", Description: "set if string or value is missing"},
+			},
+		},
+	},
+	{
+		executor: string_stats.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to measure"},
+			},
+			Outputs: []Port{
+				{Name: "words", Description: "number of words"},
+				{Name: "lines", Description: "number of lines"},
+				{Name: "runes", Description: "number of Unicode code points"},
+				{Name: "bytes", Description: "size in bytes of the UTF-8 encoding"},
+				{Name: "error", Description: "set if string is missing"},
+			},
+		},
+	},
+	{
+		executor: string_strip_html.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the HTML to strip"},
+				{Name: "allow", Description: "list of tag names to keep, e.g. [\"b\", \"i\", \"a\"] (default: none)", Optional: true},
+				{Name: "collapse_whitespace", Description: "collapse runs of spaces and blank lines as a browser would (default: true)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the text"},
+				{Name: "error", Description: "set if string is missing"},
+			},
+		},
+	},
+	{
+		executor: string_substring.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to extract from"},
+				{Name: "start", Description: "starting position (default: 0)", Optional: true},
+				{Name: "end", Description: "ending position, exclusive (default: end of string)", Optional: true},
+				{Name: "length", Description: "number of characters to take from start, instead of end", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the extracted substring"},
+				{Name: "error", Description: "set if string is missing or length is negative"},
+			},
+		},
+	},
+	{
+		executor: string_template.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "template", Description: "the template text"},
+				{Name: "data", Description: "dictionary the template is rendered against", Optional: true},
+				{Name: "use_store", Description: "render against the workflow store, with data taking precedence (default: false)", Optional: true},
+				{Name: "html", Description: "escape output for HTML (default: false)", Optional: true},
+				{Name: "strict", Description: "fail on missing keys instead of printing \"<no value>\" (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the rendered text"},
+				{Name: "error", Description: "set if the template cannot be parsed or executed, or the result would be too long"},
+			},
+		},
+	},
+	{
+		executor: string_trim.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to trim"},
+				{Name: "side", Description: "\"both\", \"left\" or \"right\" (default: \"both\")", Optional: true},
+				{Name: "cutset", Description: "characters to remove instead of whitespace", Optional: true},
+				{Name: "prefix", Description: "exact prefix to remove", Optional: true},
+				{Name: "suffix", Description: "exact suffix to remove", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the trimmed string"},
+				{Name: "error", Description: "set if string is missing or side is invalid"},
+			},
+		},
+	},
+	{
+		executor: string_truncate.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to truncate"},
+				{Name: "length", Description: "the maximum length, including the ellipsis"},
+				{Name: "unit", Description: "\"runes\" or \"bytes\" (default: \"runes\")", Optional: true},
+				{Name: "ellipsis", Description: "appended when the string is cut, e.g. \"…\" (default: \"\")", Optional: true},
+				{Name: "word_boundary", Description: "avoid cutting inside a word (default: false)", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the truncated string"},
+				{Name: "truncated", Description: "true if the string was cut"},
+				{Name: "error", Description: "set if string or length is missing, length is negative or unit is invalid"},
+			},
+		},
+	},
+	{
+		executor: string_unescape.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to unescape"},
+				{Name: "mode", Description: "html, xml, json, url, url_path or shell"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the unescaped string"},
+				{Name: "error", Description: "set if string is missing, mode is unknown or the string is not validly escaped"},
+			},
+		},
+	},
+	{
+		executor: string_upper.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "string", Description: "the string to convert"},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the uppercased string"},
+			},
+		},
+	},
+	{
+		executor: var_delete.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "key", Description: "the variable name"},
+			},
+			Outputs: []Port{
+				{Name: "success", Description: "whether the store was available"},
+				{Name: "existed", Description: "whether the variable was set before deletion"},
+			},
+		},
+	},
+	{
+		executor: var_get.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "key", Description: "the variable name"},
+				{Name: "default", Description: "value returned if the variable is not set", Optional: true},
+			},
+			Outputs: []Port{
+				{Name: "result", Description: "the variable value or default"},
+				{Name: "exists", Description: "whether the variable was set"},
+			},
+		},
+	},
+	{
+		executor: var_set.Create(),
+		schema: Schema{
+			Inputs: []Port{
+				{Name: "key", Description: "the variable name"},
+				{Name: "value", Description: "the value to store"},
+			},
+			Outputs: []Port{
+				{Name: "success", Description: "whether the variable was stored"},
+				{Name: "key", Description: "the variable name"},
+			},
+		},
+	},
+}
+
+// builtinCategories describes the categories of the builtin plugins.
+var builtinCategories = []CategoryInfo{
+	{Name: "charset", Description: "Character encoding plugins", Icon: "translate", Color: "#ec4899"},
+	{Name: "convert", Description: "Type conversion plugins", Icon: "swap_horiz", Color: "#8b5cf6"},
+	{Name: "dict", Description: "Dictionary manipulation plugins", Icon: "data_object", Color: "#0ea5e9"},
+	{Name: "eval", Description: "Expression evaluation plugins", Icon: "calculate", Color: "#f97316"},
+	{Name: "event", Description: "In-run event bus plugins", Icon: "bolt", Color: "#eab308"},
+	{Name: "list", Description: "List manipulation plugins", Icon: "list", Color: "#10b981"},
+	{Name: "logic", Description: "Boolean logic plugins", Icon: "account_tree", Color: "#6366f1"},
+	{Name: "math", Description: "Mathematical operation plugins", Icon: "functions", Color: "#ef4444"},
+	{Name: "regex", Description: "Regular expression plugins", Icon: "manage_search", Color: "#f97316"},
+	{Name: "string", Description: "String manipulation plugins", Icon: "text_fields", Color: "#14b8a6"},
+	{Name: "var", Description: "Variable management plugins", Icon: "storage", Color: "#64748b"},
+}
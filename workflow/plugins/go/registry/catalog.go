@@ -0,0 +1,68 @@
+package registry
+
+// Catalog is the node palette of a registry, shaped for visual workflow
+// builders.
+type Catalog struct {
+	Categories []CatalogCategory `json:"categories"`
+}
+
+// CatalogCategory is one palette group.
+type CatalogCategory struct {
+	CategoryInfo
+	Nodes []CatalogNode `json:"nodes"`
+}
+
+// CatalogNode is one draggable node. Icon and Color are inherited from the
+// node's category.
+type CatalogNode struct {
+	NodeType    string        `json:"node_type"`
+	Category    string        `json:"category"`
+	Description string        `json:"description"`
+	Icon        string        `json:"icon,omitempty"`
+	Color       string        `json:"color,omitempty"`
+	Inputs      []CatalogPort `json:"inputs"`
+	Outputs     []CatalogPort `json:"outputs"`
+}
+
+// CatalogPort is a node port with its position on the node. Ports are
+// ordered as documented by the plugin, required inputs first.
+type CatalogPort struct {
+	Port
+	Order int `json:"order"`
+}
+
+// Catalog returns the registry contents grouped by category, with UI hints.
+func (r *Registry) Catalog() Catalog {
+	catalog := Catalog{Categories: []CatalogCategory{}}
+	for _, node := range r.Nodes() {
+		// Nodes are ordered by category, so a new category starts a new group
+		if n := len(catalog.Categories); n == 0 || catalog.Categories[n-1].Name != node.Category {
+			catalog.Categories = append(catalog.Categories, CatalogCategory{CategoryInfo: r.CategoryInfo(node.Category)})
+		}
+		group := &catalog.Categories[len(catalog.Categories)-1]
+		group.Nodes = append(group.Nodes, CatalogNode{
+			NodeType:    node.NodeType,
+			Category:    node.Category,
+			Description: node.Description,
+			Icon:        group.Icon,
+			Color:       group.Color,
+			Inputs:      catalogPorts(node.Inputs),
+			Outputs:     catalogPorts(node.Outputs),
+		})
+	}
+	return catalog
+}
+
+// catalogPorts numbers ports, placing required ports before optional ones
+// and otherwise keeping their documented order.
+func catalogPorts(ports []Port) []CatalogPort {
+	result := make([]CatalogPort, 0, len(ports))
+	for _, optional := range []bool{false, true} {
+		for _, p := range ports {
+			if p.Optional == optional {
+				result = append(result, CatalogPort{Port: p, Order: len(result)})
+			}
+		}
+	}
+	return result
+}
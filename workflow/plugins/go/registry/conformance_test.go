@@ -0,0 +1,19 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/metabuilder/workflow-plugins-go/plugintest"
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// TestBuiltinConformance checks the NodeExecutor contract for every builtin
+// node, so a plugin that panics or mutates its inputs fails the build.
+func TestBuiltinConformance(t *testing.T) {
+	for _, node := range registry.Builtin().Nodes() {
+		node := node
+		t.Run(node.NodeType, func(t *testing.T) {
+			plugintest.RunConformance(t, node.Executor)
+		})
+	}
+}
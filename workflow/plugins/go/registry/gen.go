@@ -0,0 +1,260 @@
+//go:build ignore
+
+// gen.go generates builtin_gen.go, the list of plugins shipped with this
+// module. Every <category>/<plugin>/ directory with a factory.go is included;
+// input and output ports are parsed from the "Inputs:" and "Returns:" sections
+// of the plugin's Execute doc comment, and category descriptions and UI hints
+// from the "metadata" of each <category>/package.json.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// portLine matches a documented port such as "  - key: the key to retrieve".
+var portLine = regexp.MustCompile(`^\s*-\s+([A-Za-z0-9_]+):\s*(.*)$`)
+
+type port struct {
+	name        string
+	description string
+	optional    bool
+}
+
+type categoryInfo struct {
+	name        string
+	description string
+	icon        string
+	color       string
+}
+
+type pluginInfo struct {
+	importPath string
+	pkgName    string
+	inputs     []port
+	outputs    []port
+}
+
+func main() {
+	root := ".."
+	modulePath, err := readModulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	factories, err := filepath.Glob(filepath.Join(root, "*", "*", "factory.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(factories)
+
+	var plugins []pluginInfo
+	var categories []categoryInfo
+	for _, factory := range factories {
+		dir := filepath.Dir(factory)
+		info, err := parsePlugin(dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		info.importPath = modulePath + "/" + filepath.ToSlash(rel)
+		plugins = append(plugins, info)
+
+		catDir := filepath.Dir(dir)
+		if n := len(categories); n == 0 || categories[n-1].name != filepath.Base(catDir) {
+			category, err := parseCategory(catDir)
+			if err != nil {
+				log.Fatal(err)
+			}
+			categories = append(categories, category)
+		}
+	}
+
+	src, err := format.Source(render(plugins, categories))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("builtin_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readModulePath returns the module path declared in go.mod.
+func readModulePath(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "module ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "module ")), nil
+		}
+	}
+	return "", fmt.Errorf("%s: no module directive", path)
+}
+
+// parseCategory reads the description and UI hints of a category from its
+// package.json. A missing file leaves them empty.
+func parseCategory(dir string) (categoryInfo, error) {
+	info := categoryInfo{name: filepath.Base(dir)}
+
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if os.IsNotExist(err) {
+		return info, nil
+	} else if err != nil {
+		return info, err
+	}
+
+	var pkg struct {
+		Description string `json:"description"`
+		Metadata    struct {
+			Icon  string `json:"icon"`
+			Color string `json:"color"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return info, fmt.Errorf("%s/package.json: %w", dir, err)
+	}
+	info.description = pkg.Description
+	info.icon = pkg.Metadata.Icon
+	info.color = pkg.Metadata.Color
+	return info, nil
+}
+
+// parsePlugin extracts the package name and documented ports of one plugin.
+func parsePlugin(dir string) (pluginInfo, error) {
+	var info pluginInfo
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return info, err
+	}
+
+	fset := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return info, err
+		}
+		info.pkgName = file.Name.Name
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "Execute" || fn.Doc == nil {
+				continue
+			}
+			info.inputs, info.outputs = parsePorts(fn.Doc.Text())
+		}
+	}
+
+	if info.pkgName == "" {
+		return info, fmt.Errorf("%s: no Go files", dir)
+	}
+	return info, nil
+}
+
+// parsePorts reads the Inputs and Returns sections of a doc comment.
+func parsePorts(doc string) (inputs, outputs []port) {
+	var section *[]port
+	for _, line := range strings.Split(doc, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch trimmed {
+		case "Inputs:":
+			section = &inputs
+			continue
+		case "Returns:":
+			section = &outputs
+			continue
+		case "":
+			continue
+		}
+		if section == nil {
+			continue
+		}
+
+		if m := portLine.FindStringSubmatch(line); m != nil {
+			p := port{name: m[1], description: m[2]}
+			if strings.HasPrefix(p.description, "(optional)") {
+				p.optional = true
+				p.description = strings.TrimSpace(strings.TrimPrefix(p.description, "(optional)"))
+			}
+			*section = append(*section, p)
+		} else if n := len(*section); n > 0 && strings.HasPrefix(line, "    ") {
+			// Continuation of the previous port description
+			(*section)[n-1].description += " " + trimmed
+		} else {
+			section = nil
+		}
+	}
+	return inputs, outputs
+}
+
+// render writes the generated Go source.
+func render(plugins []pluginInfo, categories []categoryInfo) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package registry\n\n")
+	buf.WriteString("import (\n")
+	for _, p := range plugins {
+		fmt.Fprintf(&buf, "\t%q\n", p.importPath)
+	}
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("// builtins lists every plugin shipped with this module.\n")
+	buf.WriteString("var builtins = []builtin{\n")
+	for _, p := range plugins {
+		fmt.Fprintf(&buf, "\t{\n\t\texecutor: %s.Create(),\n\t\tschema: Schema{\n", p.pkgName)
+		writePorts(&buf, "Inputs", p.inputs)
+		writePorts(&buf, "Outputs", p.outputs)
+		buf.WriteString("\t\t},\n\t},\n")
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// builtinCategories describes the categories of the builtin plugins.\n")
+	buf.WriteString("var builtinCategories = []CategoryInfo{\n")
+	for _, c := range categories {
+		fmt.Fprintf(&buf, "\t{Name: %q, Description: %q, Icon: %q, Color: %q},\n", c.name, c.description, c.icon, c.color)
+	}
+	buf.WriteString("}\n")
+
+	return buf.Bytes()
+}
+
+// writePorts writes a []Port literal field.
+func writePorts(buf *bytes.Buffer, field string, ports []port) {
+	if len(ports) == 0 {
+		return
+	}
+	fmt.Fprintf(buf, "\t\t\t%s: []Port{\n", field)
+	for _, p := range ports {
+		if p.optional {
+			fmt.Fprintf(buf, "\t\t\t\t{Name: %q, Description: %q, Optional: true},\n", p.name, p.description)
+		} else {
+			fmt.Fprintf(buf, "\t\t\t\t{Name: %q, Description: %q},\n", p.name, p.description)
+		}
+	}
+	buf.WriteString("\t\t\t},\n")
+}
@@ -0,0 +1,74 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	goplugin "plugin"
+	"sort"
+)
+
+// RegisterSymbol is the name of the function a shared object must export.
+// Its type must be func(*registry.Registry) error:
+//
+//	package main
+//
+//	func Register(r *registry.Registry) error {
+//		_, err := r.Register(mynode.Create(), registry.Schema{...})
+//		return err
+//	}
+//
+// Build it with "go build -buildmode=plugin" against the same version of this
+// module, with the same Go toolchain, as the program that loads it.
+const RegisterSymbol = "Register"
+
+// LoadPlugins opens every *.so file in dir, in name order, and calls its
+// Register function with r. Shared objects that fail to load are reported
+// together in the returned error; the others are still registered.
+// A missing directory is not an error.
+func (r *Registry) LoadPlugins(dir string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.so"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	var errs []error
+	for _, path := range paths {
+		if err := r.LoadPlugin(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LoadPlugin opens one shared object and calls its Register function with r.
+func (r *Registry) LoadPlugin(path string) error {
+	p, err := goplugin.Open(path)
+	if err != nil {
+		return fmt.Errorf("registry: %w", err)
+	}
+	sym, err := p.Lookup(RegisterSymbol)
+	if err != nil {
+		return fmt.Errorf("registry: %s: %w", path, err)
+	}
+
+	var register func(*Registry) error
+	switch fn := sym.(type) {
+	case func(*Registry) error:
+		register = fn
+	case *func(*Registry) error:
+		register = *fn
+	default:
+		return fmt.Errorf("registry: %s: %s has type %T, want func(*registry.Registry) error", path, RegisterSymbol, sym)
+	}
+
+	if err := register(r); err != nil {
+		return fmt.Errorf("registry: %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+// Package registry indexes node plugins by node type.
+//
+// The built-in plugin list and their input/output ports are generated from
+// the Execute doc comments of each plugin package:
+//
+//	go generate ./registry
+package registry
+
+//go:generate go run gen.go
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+)
+
+// Port describes a single named input or output of a node.
+type Port struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Optional    bool   `json:"optional,omitempty"`
+}
+
+// Schema lists the documented inputs and outputs of a node.
+type Schema struct {
+	Inputs  []Port `json:"inputs"`
+	Outputs []Port `json:"outputs"`
+}
+
+// Node is a registered plugin together with its metadata.
+type Node struct {
+	NodeType    string `json:"node_type"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	Package     string `json:"package,omitempty"`
+	Schema
+	Executor plugin.NodeExecutor `json:"-"`
+}
+
+// CategoryInfo describes a category and how visual tools should present it.
+type CategoryInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`  // Material icon name
+	Color       string `json:"color,omitempty"` // CSS hex color
+}
+
+// Registry maps node types to plugins. It is safe for concurrent use.
+type Registry struct {
+	mu         sync.RWMutex
+	nodes      map[string]*Node
+	categories map[string]CategoryInfo
+}
+
+// New creates an empty registry.
+func New() *Registry {
+	return &Registry{
+		nodes:      make(map[string]*Node),
+		categories: make(map[string]CategoryInfo),
+	}
+}
+
+// SetCategoryInfo sets the description and UI hints of a category,
+// replacing any earlier information for info.Name.
+func (r *Registry) SetCategoryInfo(info CategoryInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.categories[info.Name] = info
+}
+
+// CategoryInfo returns the information set for a category. Categories
+// without information get one with only Name set.
+func (r *Registry) CategoryInfo(name string) CategoryInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if info, ok := r.categories[name]; ok {
+		return info
+	}
+	return CategoryInfo{Name: name}
+}
+
+// Register adds a plugin to the registry.
+// NodeType, Category and Description are read from the plugin's exported fields.
+func (r *Registry) Register(executor plugin.NodeExecutor, schema Schema) (*Node, error) {
+	if executor == nil {
+		return nil, fmt.Errorf("registry: nil executor")
+	}
+
+	node := describe(executor)
+	if node.NodeType == "" {
+		return nil, fmt.Errorf("registry: %T has no NodeType", executor)
+	}
+	node.Schema = schema
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.nodes[node.NodeType]; exists {
+		return nil, fmt.Errorf("registry: node type %q already registered", node.NodeType)
+	}
+	r.nodes[node.NodeType] = node
+	return node, nil
+}
+
+// Wrap replaces the executor of a registered node with wrap(executor),
+// keeping its metadata and schema. It is used to decorate nodes, for example
+// to run them in isolation.
+func (r *Registry) Wrap(nodeType string, wrap func(plugin.NodeExecutor) plugin.NodeExecutor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	node, ok := r.nodes[nodeType]
+	if !ok {
+		return fmt.Errorf("registry: unknown node type %q", nodeType)
+	}
+	wrapped := *node
+	wrapped.Executor = wrap(node.Executor)
+	r.nodes[nodeType] = &wrapped
+	return nil
+}
+
+// Get returns the node registered for nodeType.
+func (r *Registry) Get(nodeType string) (*Node, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	node, ok := r.nodes[nodeType]
+	return node, ok
+}
+
+// Nodes returns all registered nodes ordered by category and node type.
+func (r *Registry) Nodes() []*Node {
+	r.mu.RLock()
+	nodes := make([]*Node, 0, len(r.nodes))
+	for _, node := range r.nodes {
+		nodes = append(nodes, node)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Category != nodes[j].Category {
+			return nodes[i].Category < nodes[j].Category
+		}
+		return nodes[i].NodeType < nodes[j].NodeType
+	})
+	return nodes
+}
+
+// Categories returns the sorted set of categories with at least one node.
+func (r *Registry) Categories() []string {
+	r.mu.RLock()
+	seen := make(map[string]bool)
+	for _, node := range r.nodes {
+		seen[node.Category] = true
+	}
+	r.mu.RUnlock()
+
+	categories := make([]string, 0, len(seen))
+	for c := range seen {
+		categories = append(categories, c)
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// describe reads the metadata fields shared by all plugin structs.
+func describe(executor plugin.NodeExecutor) *Node {
+	node := &Node{Executor: executor}
+
+	v := reflect.ValueOf(executor)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return node
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return node
+	}
+
+	field := func(name string) string {
+		f := v.FieldByName(name)
+		if f.IsValid() && f.Kind() == reflect.String {
+			return f.String()
+		}
+		return ""
+	}
+
+	node.NodeType = field("NodeType")
+	node.Category = field("Category")
+	node.Description = field("Description")
+	node.Package = v.Type().PkgPath()
+	return node
+}
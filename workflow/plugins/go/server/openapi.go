@@ -0,0 +1,211 @@
+package server
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// openAPIVersion is the OpenAPI specification version of generated documents.
+const openAPIVersion = "3.0.3"
+
+// OpenAPI returns an OpenAPI 3 document describing the server's routes, with
+// one execute operation per registered node. Each node's request and output
+// schemas are built from its documented ports; required inputs are those not
+// marked optional. Ports carry no type information, so their values accept
+// any JSON type.
+func (s *Server) OpenAPI() map[string]interface{} {
+	schemas := map[string]interface{}{
+		"Error": object(map[string]interface{}{
+			"error": map[string]interface{}{"type": "string"},
+		}, "error"),
+		"Port": object(map[string]interface{}{
+			"name":        map[string]interface{}{"type": "string"},
+			"description": map[string]interface{}{"type": "string"},
+			"optional":    map[string]interface{}{"type": "boolean"},
+		}, "name"),
+		"Category": object(map[string]interface{}{
+			"name":        map[string]interface{}{"type": "string"},
+			"description": map[string]interface{}{"type": "string"},
+			"icon":        map[string]interface{}{"type": "string"},
+			"color":       map[string]interface{}{"type": "string"},
+			"nodes": arrayOf(object(map[string]interface{}{
+				"node_type":   map[string]interface{}{"type": "string"},
+				"category":    map[string]interface{}{"type": "string"},
+				"description": map[string]interface{}{"type": "string"},
+				"icon":        map[string]interface{}{"type": "string"},
+				"color":       map[string]interface{}{"type": "string"},
+				"inputs":      arrayOf(ref("CatalogPort")),
+				"outputs":     arrayOf(ref("CatalogPort")),
+			}, "node_type", "category", "description", "inputs", "outputs")),
+		}, "name", "nodes"),
+		"CatalogPort": object(map[string]interface{}{
+			"name":        map[string]interface{}{"type": "string"},
+			"description": map[string]interface{}{"type": "string"},
+			"optional":    map[string]interface{}{"type": "boolean"},
+			"order":       map[string]interface{}{"type": "integer"},
+		}, "name", "order"),
+		"Node": object(map[string]interface{}{
+			"node_type":   map[string]interface{}{"type": "string"},
+			"category":    map[string]interface{}{"type": "string"},
+			"description": map[string]interface{}{"type": "string"},
+			"package":     map[string]interface{}{"type": "string"},
+			"inputs":      arrayOf(ref("Port")),
+			"outputs":     arrayOf(ref("Port")),
+		}, "node_type", "category", "description", "inputs", "outputs"),
+	}
+
+	paths := map[string]interface{}{
+		"/v1/catalog": map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "getCatalog",
+				"summary":     "Node palette grouped by category, with UI hints",
+				"tags":        []string{"nodes"},
+				"responses": map[string]interface{}{
+					"200": jsonResponse("Node catalog", object(map[string]interface{}{
+						"categories": arrayOf(ref("Category")),
+					}, "categories")),
+				},
+			},
+		},
+		"/v1/nodes": map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "listNodes",
+				"summary":     "List node types",
+				"tags":        []string{"nodes"},
+				"parameters": []interface{}{map[string]interface{}{
+					"name": "category", "in": "query", "required": false,
+					"schema": map[string]interface{}{"type": "string"},
+				}},
+				"responses": map[string]interface{}{
+					"200": jsonResponse("Registered nodes", object(map[string]interface{}{
+						"nodes": arrayOf(ref("Node")),
+					}, "nodes")),
+				},
+			},
+		},
+		"/v1/nodes/{nodeType}": map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "describeNode",
+				"summary":     "Describe a node type",
+				"tags":        []string{"nodes"},
+				"parameters":  []interface{}{pathParam("nodeType")},
+				"responses": map[string]interface{}{
+					"200": jsonResponse("Node description", ref("Node")),
+					"404": jsonResponse("Unknown node type", ref("Error")),
+				},
+			},
+		},
+		"/v1/workflows/{name}/runs": map[string]interface{}{
+			"post": map[string]interface{}{
+				"operationId": "runWorkflow",
+				"summary":     "Run a workflow (not supported yet)",
+				"tags":        []string{"workflows"},
+				"parameters":  []interface{}{pathParam("name")},
+				"responses": map[string]interface{}{
+					"501": jsonResponse("Workflow runs are not supported", ref("Error")),
+				},
+			},
+		},
+	}
+
+	for _, node := range s.registry.Nodes() {
+		inputs, outputs := node.NodeType+".Inputs", node.NodeType+".Outputs"
+		schemas[inputs] = portsSchema(node.Inputs)
+		schemas[outputs] = portsSchema(node.Outputs)
+
+		result := object(map[string]interface{}{
+			"node_type": map[string]interface{}{"type": "string", "enum": []string{node.NodeType}},
+			"outputs":   ref(outputs),
+		}, "node_type", "outputs")
+
+		paths["/v1/nodes/"+node.NodeType+"/execute"] = map[string]interface{}{
+			"post": map[string]interface{}{
+				"operationId": "execute_" + node.NodeType,
+				"summary":     node.Description,
+				"tags":        []string{node.Category},
+				"requestBody": map[string]interface{}{
+					"required": true,
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{
+							"schema": object(map[string]interface{}{"inputs": ref(inputs)}, "inputs"),
+						},
+					},
+				},
+				"responses": map[string]interface{}{
+					"200": jsonResponse("Node outputs", result),
+					"400": jsonResponse("Invalid request body", ref("Error")),
+					"422": jsonResponse("Node outputs containing an error", result),
+				},
+			},
+		}
+	}
+
+	doc := map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":       "MetaBuilder Go Nodes API",
+			"description": "Execute MetaBuilder workflow node plugins over HTTP.",
+			"version":     "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}
+	if s.auth {
+		doc["components"].(map[string]interface{})["securitySchemes"] = map[string]interface{}{
+			"bearerAuth": map[string]interface{}{"type": "http", "scheme": "bearer"},
+		}
+		doc["security"] = []interface{}{map[string]interface{}{"bearerAuth": []string{}}}
+	}
+	return doc
+}
+
+// portsSchema builds an object schema with one property per port.
+func portsSchema(ports []registry.Port) map[string]interface{} {
+	properties := make(map[string]interface{}, len(ports))
+	var required []string
+	for _, p := range ports {
+		property := map[string]interface{}{}
+		if p.Description != "" {
+			property["description"] = p.Description
+		}
+		properties[p.Name] = property
+		if !p.Optional {
+			required = append(required, p.Name)
+		}
+	}
+	return object(properties, required...)
+}
+
+// object builds an object schema.
+func object(properties map[string]interface{}, required ...string) map[string]interface{} {
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func arrayOf(items interface{}) map[string]interface{} {
+	return map[string]interface{}{"type": "array", "items": items}
+}
+
+func ref(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+func pathParam(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name": name, "in": "path", "required": true,
+		"schema": map[string]interface{}{"type": "string"},
+	}
+}
+
+func jsonResponse(description string, schema interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"description": description,
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{"schema": schema},
+		},
+	}
+}
@@ -0,0 +1,297 @@
+// Package server exposes the node plugin registry over HTTP.
+//
+// Endpoints:
+//
+//	GET  /v1/openapi.json               OpenAPI 3 document for these endpoints
+//	GET  /v1/catalog                    node palette with UI hints
+//	GET  /v1/nodes                      list node types
+//	GET  /v1/nodes/{nodeType}           describe one node type
+//	POST /v1/nodes/{nodeType}/execute   execute a node
+//	POST /v1/workflows/{name}/runs      run a workflow (not available yet)
+//
+// Request and response bodies are JSON. Errors are returned as
+// {"error": "message"} with a matching status code.
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	plugin "github.com/metabuilder/workflow-plugins-go"
+	"github.com/metabuilder/workflow-plugins-go/registry"
+)
+
+// maxBodyBytes limits the size of request bodies.
+const maxBodyBytes = 10 << 20
+
+// Middleware wraps a handler, for example to add authentication or logging.
+type Middleware func(http.Handler) http.Handler
+
+// Authenticator checks a request before it reaches a handler.
+// A non-nil error rejects the request with 401 Unauthorized.
+type Authenticator func(r *http.Request) error
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithRegistry serves nodes from reg instead of registry.Builtin().
+func WithRegistry(reg *registry.Registry) Option {
+	return func(s *Server) {
+		s.registry = reg
+	}
+}
+
+// WithRuntime sets the function that creates the runtime for each request.
+// By default every request gets a fresh runtime with an empty store.
+func WithRuntime(newRuntime func(r *http.Request) *plugin.Runtime) Option {
+	return func(s *Server) {
+		s.newRuntime = newRuntime
+	}
+}
+
+// WithMiddleware adds middleware around all endpoints.
+// The first middleware given is the outermost.
+func WithMiddleware(m ...Middleware) Option {
+	return func(s *Server) {
+		s.middleware = append(s.middleware, m...)
+	}
+}
+
+// WithAuth rejects requests for which auth returns an error.
+func WithAuth(auth Authenticator) Option {
+	withMiddleware := WithMiddleware(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := auth(r); err != nil {
+				writeError(w, http.StatusUnauthorized, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	return func(s *Server) {
+		s.auth = true
+		withMiddleware(s)
+	}
+}
+
+// BearerToken returns an Authenticator accepting "Authorization: Bearer <token>"
+// for any of the given tokens. Empty tokens are ignored, and tokens are
+// compared in constant time so response timing does not reveal them.
+func BearerToken(tokens ...string) Authenticator {
+	allowed := make([][]byte, 0, len(tokens))
+	for _, t := range tokens {
+		if t != "" {
+			allowed = append(allowed, []byte(t))
+		}
+	}
+	return func(r *http.Request) error {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if ok && token != "" {
+			for _, t := range allowed {
+				if subtle.ConstantTimeCompare([]byte(token), t) == 1 {
+					return nil
+				}
+			}
+		}
+		return errors.New("missing or invalid bearer token")
+	}
+}
+
+// Server serves the node registry over HTTP.
+type Server struct {
+	registry   *registry.Registry
+	newRuntime func(r *http.Request) *plugin.Runtime
+	middleware []Middleware
+	auth       bool
+}
+
+// New creates a server.
+func New(opts ...Option) *Server {
+	s := &Server{
+		registry: registry.Builtin(),
+		newRuntime: func(*http.Request) *plugin.Runtime {
+			return &plugin.Runtime{
+				Store:   make(map[string]interface{}),
+				Context: make(map[string]interface{}),
+				Events:  plugin.NewEventBus(),
+			}
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Handler returns the HTTP handler for all endpoints, wrapped in middleware.
+func (s *Server) Handler() http.Handler {
+	var h http.Handler = http.HandlerFunc(s.route)
+	for i := len(s.middleware) - 1; i >= 0; i-- {
+		h = s.middleware[i](h)
+	}
+	return h
+}
+
+// ListenAndServe serves on addr until ctx is cancelled, then shuts down
+// gracefully, giving in-flight requests up to shutdownTimeout to finish.
+func (s *Server) ListenAndServe(ctx context.Context, addr string, shutdownTimeout time.Duration) error {
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// route dispatches a request by path.
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "v1" {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no route for %s", r.URL.Path))
+		return
+	}
+
+	switch {
+	case parts[1] == "openapi.json" && len(parts) == 2:
+		if allow(w, r, http.MethodGet) {
+			writeJSON(w, http.StatusOK, s.OpenAPI())
+		}
+	case parts[1] == "catalog" && len(parts) == 2:
+		if allow(w, r, http.MethodGet) {
+			writeJSON(w, http.StatusOK, s.registry.Catalog())
+		}
+	case parts[1] == "nodes" && len(parts) == 2:
+		if allow(w, r, http.MethodGet) {
+			s.listNodes(w, r)
+		}
+	case parts[1] == "nodes" && len(parts) == 3:
+		if allow(w, r, http.MethodGet) {
+			s.describeNode(w, parts[2])
+		}
+	case parts[1] == "nodes" && len(parts) == 4 && parts[3] == "execute":
+		if allow(w, r, http.MethodPost) {
+			s.executeNode(w, r, parts[2])
+		}
+	case parts[1] == "workflows" && len(parts) == 4 && parts[3] == "runs":
+		if allow(w, r, http.MethodPost) {
+			writeError(w, http.StatusNotImplemented,
+				fmt.Errorf("workflow %q: workflow runs are not supported by the Go plugin module", parts[2]))
+		}
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("no route for %s", r.URL.Path))
+	}
+}
+
+// listNodes handles GET /v1/nodes[?category=name].
+func (s *Server) listNodes(w http.ResponseWriter, r *http.Request) {
+	category := r.URL.Query().Get("category")
+	nodes := []*registry.Node{}
+	for _, node := range s.registry.Nodes() {
+		if category == "" || node.Category == category {
+			nodes = append(nodes, node)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"nodes": nodes})
+}
+
+// describeNode handles GET /v1/nodes/{nodeType}.
+func (s *Server) describeNode(w http.ResponseWriter, nodeType string) {
+	node, ok := s.registry.Get(nodeType)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown node type %q", nodeType))
+		return
+	}
+	writeJSON(w, http.StatusOK, node)
+}
+
+// executeRequest is the body of POST /v1/nodes/{nodeType}/execute.
+type executeRequest struct {
+	Inputs map[string]interface{} `json:"inputs"`
+}
+
+// executeResponse is returned by POST /v1/nodes/{nodeType}/execute.
+type executeResponse struct {
+	NodeType string                 `json:"node_type"`
+	Outputs  map[string]interface{} `json:"outputs"`
+}
+
+// executeNode handles POST /v1/nodes/{nodeType}/execute. Outputs containing
+// an "error" key are returned with 422 Unprocessable Entity.
+func (s *Server) executeNode(w http.ResponseWriter, r *http.Request, nodeType string) {
+	node, ok := s.registry.Get(nodeType)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown node type %q", nodeType))
+		return
+	}
+
+	var req executeRequest
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+	if req.Inputs == nil {
+		req.Inputs = make(map[string]interface{})
+	}
+
+	outputs := node.Executor.Execute(req.Inputs, s.newRuntime(r))
+	status := http.StatusOK
+	if outputs["error"] != nil {
+		status = http.StatusUnprocessableEntity
+	}
+	writeJSON(w, status, executeResponse{NodeType: nodeType, Outputs: outputs})
+}
+
+// allow reports whether r uses method, writing 405 Method Not Allowed otherwise.
+func allow(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method {
+		return true
+	}
+	w.Header().Set("Allow", method)
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	return false
+}
+
+// writeJSON writes v as a JSON response. If v cannot be encoded, such as a
+// node output holding NaN, a 500 error is written instead.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(map[string]string{"error": "cannot encode response: " + err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(data, '\n'))
+}
+
+// writeError writes {"error": err} as a JSON response.
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
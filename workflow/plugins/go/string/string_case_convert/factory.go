@@ -0,0 +1,7 @@
+// Package string_case_convert provides factory for StringCaseConvert plugin.
+package string_case_convert
+
+// Create returns a new StringCaseConvert instance.
+func Create() *StringCaseConvert {
+	return NewStringCaseConvert()
+}
@@ -0,0 +1,55 @@
+// Package string_case_convert provides a workflow plugin for converting naming conventions.
+package string_case_convert
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/casing"
+)
+
+// StringCaseConvert implements the NodeExecutor interface for converting naming conventions.
+type StringCaseConvert struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringCaseConvert creates a new StringCaseConvert instance.
+func NewStringCaseConvert() *StringCaseConvert {
+	return &StringCaseConvert{
+		NodeType:    "string.case_convert",
+		Category:    "string",
+		Description: "Convert an identifier between naming conventions",
+	}
+}
+
+// Execute runs the plugin logic.
+// The input may be in any convention: words are split at separators, at
+// lower-to-upper changes and after acronyms, so "parseHTTPResponse2xx"
+// becomes parse_http_response2xx in snake case. Digits stay with the
+// preceding word. dict.transform_keys converts the keys of a dictionary
+// the same way.
+// Inputs:
+//   - string: the identifier to convert
+//   - to: target style: snake, camel, kebab, pascal, constant or title
+//
+// Returns:
+//   - result: the converted identifier
+//   - words: the words the identifier was split into
+//   - error: set if string is missing or to is unknown
+func (p *StringCaseConvert) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "words": []interface{}{}, "error": "string is required"}
+	}
+	to, _ := inputs["to"].(string)
+
+	result, err := casing.Convert(str, to)
+	if err != nil {
+		return map[string]interface{}{"result": "", "words": []interface{}{}, "error": err.Error()}
+	}
+
+	words := []interface{}{}
+	for _, w := range casing.Words(str) {
+		words = append(words, w)
+	}
+	return map[string]interface{}{"result": result, "words": words}
+}
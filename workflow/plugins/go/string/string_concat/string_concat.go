@@ -3,6 +3,7 @@ package string_concat
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -23,6 +24,14 @@ func NewStringConcat() *StringConcat {
 }
 
 // Execute runs the plugin logic.
+// Values are formatted with %v.
+// Inputs:
+//   - strings: list of values to concatenate
+//   - separator: (optional) string placed between values (default: "")
+//
+// Returns:
+//   - result: the concatenated string
+//   - error: set if strings is not a list
 func (p *StringConcat) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	strs, ok := inputs["strings"].([]interface{})
 	if !ok {
@@ -34,10 +43,28 @@ func (p *StringConcat) Execute(inputs map[string]interface{}, runtime interface{
 		separator = sep
 	}
 
-	strList := make([]string, len(strs))
+	parts := make([]string, len(strs))
 	for i, s := range strs {
-		strList[i] = fmt.Sprintf("%v", s)
+		parts[i] = format(s)
 	}
 
-	return map[string]interface{}{"result": strings.Join(strList, separator)}
+	return map[string]interface{}{"result": strings.Join(parts, separator)}
+}
+
+// format returns v as fmt's %v would, without going through fmt for the
+// common types.
+func format(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprintf("%v", v)
 }
@@ -0,0 +1,7 @@
+// Package string_contains provides factory for StringContains plugin.
+package string_contains
+
+// Create returns a new StringContains instance.
+func Create() *StringContains {
+	return NewStringContains()
+}
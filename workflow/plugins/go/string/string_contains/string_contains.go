@@ -0,0 +1,48 @@
+// Package string_contains provides a workflow plugin for checking whether a string contains a substring.
+package string_contains
+
+import (
+	"strings"
+)
+
+// StringContains implements the NodeExecutor interface for checking whether a string contains a substring.
+type StringContains struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringContains creates a new StringContains instance.
+func NewStringContains() *StringContains {
+	return &StringContains{
+		NodeType:    "string.contains",
+		Category:    "string",
+		Description: "Check whether a string contains a substring",
+	}
+}
+
+// Execute runs the plugin logic.
+// Inputs:
+//   - string: the string to check
+//   - value: the substring to look for
+//   - ignore_case: (optional) compare case-insensitively (default: false)
+//
+// Returns:
+//   - result: true if the check passes
+//   - error: set if string or value is missing
+func (p *StringContains) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": false, "error": "string is required"}
+	}
+	value, ok := inputs["value"].(string)
+	if !ok {
+		return map[string]interface{}{"result": false, "error": "value is required"}
+	}
+
+	if ignoreCase, _ := inputs["ignore_case"].(bool); ignoreCase {
+		str, value = strings.ToLower(str), strings.ToLower(value)
+	}
+
+	return map[string]interface{}{"result": strings.Contains(str, value)}
+}
@@ -0,0 +1,7 @@
+// Package string_ends_with provides factory for StringEndsWith plugin.
+package string_ends_with
+
+// Create returns a new StringEndsWith instance.
+func Create() *StringEndsWith {
+	return NewStringEndsWith()
+}
@@ -0,0 +1,48 @@
+// Package string_ends_with provides a workflow plugin for checking string suffixes.
+package string_ends_with
+
+import (
+	"strings"
+)
+
+// StringEndsWith implements the NodeExecutor interface for checking string suffixes.
+type StringEndsWith struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringEndsWith creates a new StringEndsWith instance.
+func NewStringEndsWith() *StringEndsWith {
+	return &StringEndsWith{
+		NodeType:    "string.ends_with",
+		Category:    "string",
+		Description: "Check whether a string ends with a suffix",
+	}
+}
+
+// Execute runs the plugin logic.
+// Inputs:
+//   - string: the string to check
+//   - value: the suffix to check for
+//   - ignore_case: (optional) compare case-insensitively (default: false)
+//
+// Returns:
+//   - result: true if the check passes
+//   - error: set if string or value is missing
+func (p *StringEndsWith) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": false, "error": "string is required"}
+	}
+	value, ok := inputs["value"].(string)
+	if !ok {
+		return map[string]interface{}{"result": false, "error": "value is required"}
+	}
+
+	if ignoreCase, _ := inputs["ignore_case"].(bool); ignoreCase {
+		str, value = strings.ToLower(str), strings.ToLower(value)
+	}
+
+	return map[string]interface{}{"result": strings.HasSuffix(str, value)}
+}
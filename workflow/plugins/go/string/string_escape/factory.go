@@ -0,0 +1,7 @@
+// Package string_escape provides factory for StringEscape plugin.
+package string_escape
+
+// Create returns a new StringEscape instance.
+func Create() *StringEscape {
+	return NewStringEscape()
+}
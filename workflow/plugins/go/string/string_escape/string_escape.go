@@ -0,0 +1,103 @@
+// Package string_escape provides a workflow plugin for escaping strings.
+package string_escape
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+)
+
+// StringEscape implements the NodeExecutor interface for escaping strings.
+type StringEscape struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringEscape creates a new StringEscape instance.
+func NewStringEscape() *StringEscape {
+	return &StringEscape{
+		NodeType:    "string.escape",
+		Category:    "string",
+		Description: "Escape a string for HTML, XML, JSON, URLs or the shell",
+	}
+}
+
+// Execute runs the plugin logic.
+// Modes:
+//   - html: <, >, &, ' and " as character references
+//   - xml: like html, plus tabs and line breaks, for attribute values
+//   - json: the contents of a JSON string literal, without the quotes
+//   - url: a URL query component (spaces become +)
+//   - url_path: a URL path segment (spaces become %20)
+//   - shell: a single POSIX shell word, quoted when needed
+//
+// string.unescape reverses each mode.
+// Inputs:
+//   - string: the string to escape
+//   - mode: html, xml, json, url, url_path or shell
+//
+// Returns:
+//   - result: the escaped string
+//   - error: set if string is missing or mode is unknown
+func (p *StringEscape) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "string is required"}
+	}
+
+	var result string
+	switch mode, _ := inputs["mode"].(string); mode {
+	case "html":
+		result = html.EscapeString(str)
+	case "xml":
+		var b strings.Builder
+		if err := xml.EscapeText(&b, []byte(str)); err != nil {
+			return map[string]interface{}{"result": "", "error": err.Error()}
+		}
+		result = b.String()
+	case "json":
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(str); err != nil {
+			return map[string]interface{}{"result": "", "error": err.Error()}
+		}
+		encoded := strings.TrimSuffix(b.String(), "\n")
+		result = encoded[1 : len(encoded)-1]
+	case "url":
+		result = url.QueryEscape(str)
+	case "url_path":
+		result = url.PathEscape(str)
+	case "shell":
+		result = shellQuote(str)
+	default:
+		return map[string]interface{}{"result": "", "error": fmt.Sprintf("unknown mode %q (want html, xml, json, url, url_path or shell)", mode)}
+	}
+
+	return map[string]interface{}{"result": result}
+}
+
+// shellQuote returns s as one shell word: unchanged when it only holds
+// safe characters, otherwise in single quotes. An embedded single quote
+// closes the quoting, is written escaped and reopens it.
+func shellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	safe := true
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("@%+=:,./-_", r)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,7 @@
+// Package string_format provides factory for StringFormat plugin.
+package string_format
+
+// Create returns a new StringFormat instance.
+func Create() *StringFormat {
+	return NewStringFormat()
+}
@@ -0,0 +1,121 @@
+// Package string_format provides a workflow plugin for printf-style formatting.
+package string_format
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// StringFormat implements the NodeExecutor interface for printf-style formatting.
+type StringFormat struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringFormat creates a new StringFormat instance.
+func NewStringFormat() *StringFormat {
+	return &StringFormat{
+		NodeType:    "string.format",
+		Category:    "string",
+		Description: "Format a printf-style template with arguments",
+	}
+}
+
+// Execute runs the plugin logic.
+// Verbs follow Go's fmt package, e.g. "%s has %d items" or "%-10s|%6.2f",
+// with flags, width and precision written out (no * or explicit argument
+// indexes). Arguments are adapted to the verb: whole numbers work with %d,
+// %x and %c, and %s and %q print any value the way convert.to_string does.
+// Verbs without an argument are left in the output as written and extra
+// arguments are ignored; both are counted rather than reported as errors.
+// Inputs:
+//   - format: the template
+//   - args: (optional) list of arguments, used in order
+//
+// Returns:
+//   - result: the formatted string
+//   - missing: number of verbs that had no argument
+//   - unused: number of arguments that were not used
+//   - error: set if format is missing
+func (p *StringFormat) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	format, ok := inputs["format"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "missing": 0, "unused": 0, "error": "format is required"}
+	}
+	args, _ := inputs["args"].([]interface{})
+
+	var b strings.Builder
+	next, missing := 0, 0
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			j := strings.IndexByte(format[i:], '%')
+			if j < 0 {
+				j = len(format) - i
+			}
+			b.WriteString(format[i : i+j])
+			i += j
+			continue
+		}
+		spec, verb := scanVerb(format[i:])
+		i += len(spec)
+		switch {
+		case verb == 0:
+			// Incomplete verb at the end of the template
+			b.WriteString(spec)
+		case verb == '%':
+			b.WriteByte('%')
+		case next >= len(args):
+			missing++
+			b.WriteString(spec)
+		default:
+			fmt.Fprintf(&b, spec, adapt(verb, args[next]))
+			next++
+		}
+	}
+
+	return map[string]interface{}{"result": b.String(), "missing": missing, "unused": len(args) - next}
+}
+
+// scanVerb returns the verb at the start of s (which begins with %) and
+// its letter, or 0 when s ends before the verb does.
+func scanVerb(s string) (string, rune) {
+	i := 1
+	for i < len(s) && strings.IndexByte("+-# 0", s[i]) >= 0 {
+		i++
+	}
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+		i++
+	}
+	if i >= len(s) {
+		return s, 0
+	}
+	r := []rune(s[i:])[0]
+	return s[:i+len(string(r))], r
+}
+
+// adapt converts an argument to the type the verb expects.
+func adapt(verb rune, arg interface{}) interface{} {
+	switch verb {
+	case 'd', 'x', 'X', 'o', 'O', 'b', 'c', 'U':
+		if f, ok := arg.(float64); ok && f == math.Trunc(f) && math.Abs(f) < 1<<63 {
+			return int64(f)
+		}
+	case 's', 'q':
+		switch v := arg.(type) {
+		case string:
+			return v
+		case nil:
+			return ""
+		case fmt.Stringer, error:
+			return v
+		default:
+			if data, err := json.Marshal(v); err == nil {
+				return string(data)
+			}
+		}
+	}
+	return arg
+}
@@ -0,0 +1,7 @@
+// Package string_index_of provides factory for StringIndexOf plugin.
+package string_index_of
+
+// Create returns a new StringIndexOf instance.
+func Create() *StringIndexOf {
+	return NewStringIndexOf()
+}
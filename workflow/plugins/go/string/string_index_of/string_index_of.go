@@ -0,0 +1,80 @@
+// Package string_index_of provides a workflow plugin for locating substrings.
+package string_index_of
+
+import (
+	"unicode"
+)
+
+// StringIndexOf implements the NodeExecutor interface for finding substrings.
+type StringIndexOf struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringIndexOf creates a new StringIndexOf instance.
+func NewStringIndexOf() *StringIndexOf {
+	return &StringIndexOf{
+		NodeType:    "string.index_of",
+		Category:    "string",
+		Description: "Find the position of a substring",
+	}
+}
+
+// Execute runs the plugin logic.
+// Positions count runes, not bytes, so they can be passed straight to
+// string.substring. An empty value is found at the start and the end.
+// Inputs:
+//   - string: the string to search
+//   - value: the substring to look for
+//   - ignore_case: (optional) compare case-insensitively (default: false)
+//
+// Returns:
+//   - index: rune index of the first occurrence, or -1
+//   - last_index: rune index of the last occurrence, or -1
+//   - error: set if string or value is missing
+func (p *StringIndexOf) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"index": -1, "last_index": -1, "error": "string is required"}
+	}
+	value, ok := inputs["value"].(string)
+	if !ok {
+		return map[string]interface{}{"index": -1, "last_index": -1, "error": "value is required"}
+	}
+	ignoreCase, _ := inputs["ignore_case"].(bool)
+
+	// Compare rune by rune rather than lowering the strings, since lowering
+	// can change the length and so shift the positions
+	equal := func(a, b rune) bool { return a == b }
+	if ignoreCase {
+		equal = func(a, b rune) bool { return a == b || unicode.ToLower(a) == unicode.ToLower(b) }
+	}
+	s, sub := []rune(str), []rune(value)
+	matchAt := func(i int) bool {
+		for j, r := range sub {
+			if !equal(s[i+j], r) {
+				return false
+			}
+		}
+		return true
+	}
+
+	index, lastIndex := -1, -1
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if matchAt(i) {
+			index = i
+			break
+		}
+	}
+	if index >= 0 {
+		for i := len(s) - len(sub); i >= index; i-- {
+			if matchAt(i) {
+				lastIndex = i
+				break
+			}
+		}
+	}
+
+	return map[string]interface{}{"index": index, "last_index": lastIndex}
+}
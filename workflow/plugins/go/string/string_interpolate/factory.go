@@ -0,0 +1,7 @@
+// Package string_interpolate provides factory for StringInterpolate plugin.
+package string_interpolate
+
+// Create returns a new StringInterpolate instance.
+func Create() *StringInterpolate {
+	return NewStringInterpolate()
+}
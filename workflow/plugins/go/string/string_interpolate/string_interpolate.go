@@ -0,0 +1,121 @@
+// Package string_interpolate provides a workflow plugin for filling placeholders in strings.
+package string_interpolate
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/metabuilder/workflow-plugins-go/paths"
+)
+
+// StringInterpolate implements the NodeExecutor interface for interpolating strings.
+type StringInterpolate struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringInterpolate creates a new StringInterpolate instance.
+func NewStringInterpolate() *StringInterpolate {
+	return &StringInterpolate{
+		NodeType:    "string.interpolate",
+		Category:    "string",
+		Description: "Fill {{name}} placeholders from the workflow store",
+	}
+}
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// placeholder matches {{name}}, allowing spaces inside the braces.
+var placeholder = regexp.MustCompile(`\{\{\s*([^{}\s][^{}]*?)\s*\}\}`)
+
+// Execute runs the plugin logic.
+// Each {{name}} is replaced with the value at that path (dot notation, e.g.
+// {{user.name}}), looked up in values and then in the workflow store.
+// Values are formatted like convert.to_string. For conditionals, loops or
+// functions use string.template.
+// Inputs:
+//   - string: the text containing placeholders
+//   - values: (optional) dictionary of values, taking precedence over the store
+//   - strict: (optional) fail if a placeholder cannot be resolved, instead of leaving it in place (default: false)
+//
+// Returns:
+//   - result: the interpolated string
+//   - unresolved: list of placeholder names that could not be resolved
+//   - error: set if string is missing or, when strict, a placeholder is unresolved
+func (p *StringInterpolate) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "unresolved": []interface{}{}, "error": "string is required"}
+	}
+	values, _ := inputs["values"].(map[string]interface{})
+	store := storeOf(runtime)
+	strict, _ := inputs["strict"].(bool)
+
+	unresolved := []interface{}{}
+	seen := map[string]bool{}
+	result := placeholder.ReplaceAllStringFunc(str, func(match string) string {
+		name := placeholder.FindStringSubmatch(match)[1]
+		if path, err := paths.Parse(name); err == nil {
+			for _, source := range []map[string]interface{}{values, store} {
+				if source == nil {
+					continue
+				}
+				if v, ok := paths.Get(source, path); ok {
+					return stringify(v)
+				}
+			}
+		}
+		if !seen[name] {
+			seen[name] = true
+			unresolved = append(unresolved, name)
+		}
+		return match
+	})
+
+	if strict && len(unresolved) > 0 {
+		names := make([]string, len(unresolved))
+		for i, name := range unresolved {
+			names[i] = name.(string)
+		}
+		return map[string]interface{}{
+			"result":     "",
+			"unresolved": unresolved,
+			"error":      fmt.Sprintf("unresolved placeholders: %s", strings.Join(names, ", ")),
+		}
+	}
+
+	return map[string]interface{}{"result": result, "unresolved": unresolved}
+}
+
+// stringify formats a value like convert.to_string.
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		if data, err := json.Marshal(v); err == nil {
+			return string(data)
+		}
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func storeOf(runtime interface{}) map[string]interface{} {
+	if r, ok := runtime.(Runtime); ok {
+		return r.GetStore()
+	}
+	if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			return s
+		}
+	}
+	return nil
+}
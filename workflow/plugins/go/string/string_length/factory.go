@@ -0,0 +1,7 @@
+// Package string_length provides factory for StringLength plugin.
+package string_length
+
+// Create returns a new StringLength instance.
+func Create() *StringLength {
+	return NewStringLength()
+}
@@ -0,0 +1,52 @@
+// Package string_length provides a workflow plugin for measuring strings.
+package string_length
+
+import (
+	"unicode/utf8"
+
+	"github.com/metabuilder/workflow-plugins-go/grapheme"
+)
+
+// StringLength implements the NodeExecutor interface for measuring strings.
+type StringLength struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringLength creates a new StringLength instance.
+func NewStringLength() *StringLength {
+	return &StringLength{
+		NodeType:    "string.length",
+		Category:    "string",
+		Description: "Count the length of a string",
+	}
+}
+
+// Execute runs the plugin logic.
+// "é" written with a combining accent is 3 bytes, 2 runes and 1 grapheme;
+// use graphemes to validate what users see as characters.
+// Inputs:
+//   - string: the string to measure
+//   - unit: (optional) "bytes" (UTF-8), "runes" (code points) or "graphemes" (user-perceived characters) (default: "runes")
+//
+// Returns:
+//   - result: the length in the chosen unit
+//   - error: set if string is missing or unit is invalid
+func (p *StringLength) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": 0, "error": "string is required"}
+	}
+
+	switch unit, _ := inputs["unit"].(string); unit {
+	case "", "runes":
+		return map[string]interface{}{"result": utf8.RuneCountInString(str)}
+	case "bytes":
+		return map[string]interface{}{"result": len(str)}
+	case "graphemes":
+		return map[string]interface{}{"result": grapheme.Count(str)}
+	default:
+		return map[string]interface{}{"result": 0, "error": "unit must be \"bytes\", \"runes\" or \"graphemes\""}
+	}
+}
@@ -22,6 +22,11 @@ func NewStringLower() *StringLower {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - string: the string to convert
+//
+// Returns:
+//   - result: the lowercased string
 func (p *StringLower) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	str, ok := inputs["string"].(string)
 	if !ok {
@@ -0,0 +1,7 @@
+// Package string_mask provides factory for StringMask plugin.
+package string_mask
+
+// Create returns a new StringMask instance.
+func Create() *StringMask {
+	return NewStringMask()
+}
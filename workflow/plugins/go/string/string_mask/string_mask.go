@@ -0,0 +1,188 @@
+// Package string_mask provides a workflow plugin for redacting sensitive text.
+package string_mask
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// StringMask implements the NodeExecutor interface for masking strings.
+type StringMask struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringMask creates a new StringMask instance.
+func NewStringMask() *StringMask {
+	return &StringMask{
+		NodeType:    "string.mask",
+		Category:    "string",
+		Description: "Mask sensitive parts of a string",
+	}
+}
+
+// Execute runs the plugin logic.
+// Without patterns the whole string is masked except for keep_first and
+// keep_last runes. With patterns only matches are masked, each in its own
+// way:
+//   - email: the local part except its first character ("j***@example.com")
+//   - credit_card: all but the last four digits, for 13 to 19 digit numbers
+//     that pass the Luhn check
+//   - phone: all but the last two digits, for 7 to 15 digit numbers other
+//     than dates such as 2024-01-15
+//
+// Separators such as spaces and dashes are kept.
+// Inputs:
+//   - string: the string to mask
+//   - keep_first: (optional) runes to leave visible at the start (default: 0)
+//   - keep_last: (optional) runes to leave visible at the end (default: 0)
+//   - mask_char: (optional) replacement for each masked character (default: "*")
+//   - patterns: (optional) list of "email", "credit_card" and "phone" to mask within the string
+//
+// Returns:
+//   - result: the masked string
+//   - count: number of masked values
+//   - error: set if string is missing or a pattern is unknown
+func (p *StringMask) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "count": 0, "error": "string is required"}
+	}
+
+	maskChar := "*"
+	if m, ok := inputs["mask_char"].(string); ok && m != "" {
+		maskChar = m
+	}
+
+	patterns, ok := inputs["patterns"].([]interface{})
+	if !ok || len(patterns) == 0 {
+		keepFirst, _ := toInt(inputs["keep_first"])
+		keepLast, _ := toInt(inputs["keep_last"])
+		if str == "" {
+			return map[string]interface{}{"result": "", "count": 0}
+		}
+		return map[string]interface{}{"result": maskRunes(str, keepFirst, keepLast, maskChar), "count": 1}
+	}
+
+	count := 0
+	result := str
+	for _, name := range patterns {
+		name, _ := name.(string)
+		var masked int
+		switch name {
+		case "email":
+			result, masked = maskEmails(result, maskChar)
+		case "credit_card":
+			result, masked = maskDigits(result, cardPattern, 13, 19, 4, luhn, maskChar)
+		case "phone":
+			result, masked = maskDigits(result, phonePattern, 7, 15, 2, notDate, maskChar)
+		default:
+			return map[string]interface{}{"result": "", "count": 0, "error": fmt.Sprintf("unknown pattern %q (want email, credit_card or phone)", name)}
+		}
+		count += masked
+	}
+
+	return map[string]interface{}{"result": result, "count": count}
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	cardPattern  = regexp.MustCompile(`\b\d(?:[ \-]?\d){12,18}\b`)
+	phonePattern = regexp.MustCompile(`\+?\(?\d(?:[ ().\-]{0,2}\d){6,14}\b`)
+	datePattern  = regexp.MustCompile(`^\d{4}[\-.]\d{2}[\-.]\d{2}$|^\d{2}[\-.]\d{2}[\-.]\d{4}$`)
+)
+
+// maskRunes masks all of s but the first keepFirst and last keepLast runes.
+// If those would cover the whole string, all of it is masked rather than
+// revealed.
+func maskRunes(s string, keepFirst, keepLast int, maskChar string) string {
+	runes := []rune(s)
+	keepFirst, keepLast = max(keepFirst, 0), max(keepLast, 0)
+	if keepFirst+keepLast >= len(runes) {
+		keepFirst, keepLast = 0, 0
+	}
+	return string(runes[:keepFirst]) + strings.Repeat(maskChar, len(runes)-keepFirst-keepLast) + string(runes[len(runes)-keepLast:])
+}
+
+func maskEmails(s, maskChar string) (string, int) {
+	count := 0
+	result := emailPattern.ReplaceAllStringFunc(s, func(email string) string {
+		count++
+		at := strings.LastIndexByte(email, '@')
+		return maskRunes(email[:at], 1, 0, maskChar) + email[at:]
+	})
+	return result, count
+}
+
+// maskDigits masks the digits of pattern matches holding between minDigits
+// and maxDigits digits and accepted by valid, leaving the last keep digits.
+func maskDigits(s string, pattern *regexp.Regexp, minDigits, maxDigits, keep int, valid func(digits, match string) bool, maskChar string) (string, int) {
+	count := 0
+	result := pattern.ReplaceAllStringFunc(s, func(match string) string {
+		var digits strings.Builder
+		for _, r := range match {
+			if unicode.IsDigit(r) {
+				digits.WriteRune(r)
+			}
+		}
+		n := digits.Len()
+		if n < minDigits || n > maxDigits || !valid(digits.String(), match) {
+			return match
+		}
+		count++
+
+		var b strings.Builder
+		seen := 0
+		for _, r := range match {
+			if unicode.IsDigit(r) {
+				seen++
+				if seen <= n-keep {
+					b.WriteString(maskChar)
+					continue
+				}
+			}
+			b.WriteRune(r)
+		}
+		return b.String()
+	})
+	return result, count
+}
+
+// notDate reports whether a phone number match is not a date.
+func notDate(_, match string) bool {
+	return !datePattern.MatchString(match)
+}
+
+// luhn reports whether the digits pass the Luhn checksum.
+func luhn(digits, _ string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
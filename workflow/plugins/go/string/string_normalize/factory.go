@@ -0,0 +1,7 @@
+// Package string_normalize provides factory for StringNormalize plugin.
+package string_normalize
+
+// Create returns a new StringNormalize instance.
+func Create() *StringNormalize {
+	return NewStringNormalize()
+}
@@ -0,0 +1,56 @@
+// Package string_normalize provides a workflow plugin for Unicode normalization.
+package string_normalize
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/normalize"
+)
+
+// StringNormalize implements the NodeExecutor interface for Unicode normalization.
+type StringNormalize struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringNormalize creates a new StringNormalize instance.
+func NewStringNormalize() *StringNormalize {
+	return &StringNormalize{
+		NodeType:    "string.normalize",
+		Category:    "string",
+		Description: "Apply Unicode normalization to a string",
+	}
+}
+
+// Execute runs the plugin logic.
+// Normalize text from different sources before comparing or
+// deduplicating it: "café" typed with a precomposed é and with e plus a
+// combining accent become the same string. The K forms also fold
+// compatibility variants, e.g. "ﬁ" to "fi" and full-width "Ａ" to "A".
+// Inputs:
+//   - string: the string to normalize
+//   - form: (optional) NFC, NFD, NFKC or NFKD (default: NFC)
+//   - strip_marks: (optional) remove diacritics, e.g. "Crème" to "Creme" (default: false)
+//
+// Returns:
+//   - result: the normalized string
+//   - error: set if string is missing or form is unknown
+func (p *StringNormalize) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "string is required"}
+	}
+	form := "NFC"
+	if f, ok := inputs["form"].(string); ok && f != "" {
+		form = f
+	}
+
+	if strip, _ := inputs["strip_marks"].(bool); strip {
+		str = normalize.StripMarks(str)
+	}
+	result, err := normalize.String(str, form)
+	if err != nil {
+		return map[string]interface{}{"result": "", "error": err.Error()}
+	}
+
+	return map[string]interface{}{"result": result}
+}
@@ -0,0 +1,7 @@
+// Package string_pad provides factory for StringPad plugin.
+package string_pad
+
+// Create returns a new StringPad instance.
+func Create() *StringPad {
+	return NewStringPad()
+}
@@ -0,0 +1,113 @@
+// Package string_pad provides a workflow plugin for padding strings.
+package string_pad
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/metabuilder/workflow-plugins-go/intconv"
+)
+
+// StringPad implements the NodeExecutor interface for padding strings.
+type StringPad struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringPad creates a new StringPad instance.
+func NewStringPad() *StringPad {
+	return &StringPad{
+		NodeType:    "string.pad",
+		Category:    "string",
+		Description: "Pad a string to a length",
+	}
+}
+
+// maxLength bounds the padded length so a wrong input cannot exhaust memory.
+const maxLength = 10 << 20
+
+// Execute runs the plugin logic.
+// Lengths count characters (runes). The pad string is repeated and cut to
+// fit, so padding "7" to 3 on the left with "0" gives "007". Centering puts
+// the odd character on the right. Strings already long enough are returned
+// unchanged.
+// Inputs:
+//   - string: the string to pad
+//   - length: the length to pad to, at most 10485760
+//   - side: (optional) where to add padding: "left", "right" or "center" (default: "right")
+//   - pad: (optional) the padding string (default: " ")
+//
+// Returns:
+//   - result: the padded string
+//   - error: set if string or length is missing, length is too large, side is invalid or pad is empty
+func (p *StringPad) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": "", "error": fmt.Sprintf(format, args...)}
+	}
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return fail("string is required")
+	}
+	length, ok := intconv.Int(inputs["length"])
+	if !ok {
+		return fail("length is required")
+	}
+	if length > maxLength {
+		return fail("length must be at most %d", maxLength)
+	}
+	pad := " "
+	if s, ok := inputs["pad"].(string); ok {
+		if s == "" {
+			return fail("pad must not be empty")
+		}
+		pad = s
+	}
+
+	side, _ := inputs["side"].(string)
+	switch side {
+	case "", "left", "right", "center":
+	default:
+		return fail("side must be \"left\", \"right\" or \"center\"")
+	}
+
+	current := utf8.RuneCountInString(str)
+	if length <= current {
+		return map[string]interface{}{"result": str}
+	}
+	missing := length - current
+
+	left, right := 0, missing
+	switch side {
+	case "left":
+		left, right = missing, 0
+	case "center":
+		left = missing / 2
+		right = missing - left
+	}
+
+	return map[string]interface{}{"result": fill(pad, left) + str + fill(pad, right)}
+}
+
+// fill repeats pad to exactly n runes.
+func fill(pad string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	padLen := utf8.RuneCountInString(pad)
+	var b strings.Builder
+	b.Grow(n/padLen*len(pad) + len(pad))
+	for ; n >= padLen; n -= padLen {
+		b.WriteString(pad)
+	}
+	// Finish with the first n runes of pad
+	for _, r := range pad {
+		if n == 0 {
+			break
+		}
+		b.WriteRune(r)
+		n--
+	}
+	return b.String()
+}
@@ -0,0 +1,7 @@
+// Package string_repeat provides factory for StringRepeat plugin.
+package string_repeat
+
+// Create returns a new StringRepeat instance.
+func Create() *StringRepeat {
+	return NewStringRepeat()
+}
@@ -0,0 +1,76 @@
+// Package string_repeat provides a workflow plugin for repeating strings.
+package string_repeat
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/metabuilder/workflow-plugins-go/intconv"
+)
+
+// StringRepeat implements the NodeExecutor interface for repeating strings.
+type StringRepeat struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringRepeat creates a new StringRepeat instance.
+func NewStringRepeat() *StringRepeat {
+	return &StringRepeat{
+		NodeType:    "string.repeat",
+		Category:    "string",
+		Description: "Repeat a string a number of times",
+	}
+}
+
+// maxLength bounds the size of the result in bytes.
+const maxLength = 10 << 20
+
+// Execute runs the plugin logic.
+// Results longer than 10 MiB are refused rather than built.
+// Inputs:
+//   - string: the string to repeat
+//   - count: number of repetitions
+//   - separator: (optional) string placed between repetitions (default: "")
+//
+// Returns:
+//   - result: the repeated string
+//   - error: set if string or count is missing, count is negative or the result would be too long
+func (p *StringRepeat) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": "", "error": fmt.Sprintf(format, args...)}
+	}
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return fail("string is required")
+	}
+	count, ok := intconv.Int(inputs["count"])
+	if !ok {
+		return fail("count is required")
+	}
+	if count < 0 {
+		return fail("count must not be negative")
+	}
+	separator, _ := inputs["separator"].(string)
+	if count == 0 || str == "" && separator == "" {
+		return map[string]interface{}{"result": ""}
+	}
+
+	// Compare in float64 so huge counts cannot overflow the size
+	size := float64(len(str))*float64(count) + float64(len(separator))*float64(count-1)
+	if size > maxLength {
+		return fail("result would be %.0f bytes, more than the limit of %d", size, maxLength)
+	}
+
+	var b strings.Builder
+	b.Grow(int(size))
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			b.WriteString(separator)
+		}
+		b.WriteString(str)
+	}
+
+	return map[string]interface{}{"result": b.String()}
+}
@@ -3,6 +3,8 @@ package string_replace
 
 import (
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // StringReplace implements the NodeExecutor interface for replacing strings.
@@ -22,6 +24,20 @@ func NewStringReplace() *StringReplace {
 }
 
 // Execute runs the plugin logic.
+// With whole_word, an occurrence only matches when it is not part of a
+// longer word: the characters around it must not be letters, digits or
+// underscores. Use string.replace_many for several replacements and
+// regex plugins for patterns.
+// Inputs:
+//   - string: the string to search
+//   - old: the substring to replace
+//   - new: the replacement
+//   - count: (optional) maximum number of replacements (default: -1, all)
+//   - ignore_case: (optional) match old case-insensitively (default: false)
+//   - whole_word: (optional) only replace whole words (default: false)
+//
+// Returns:
+//   - result: the string with replacements applied
 func (p *StringReplace) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	str, ok := inputs["string"].(string)
 	if !ok {
@@ -33,10 +49,83 @@ func (p *StringReplace) Execute(inputs map[string]interface{}, runtime interface
 
 	// Default to replace all (-1)
 	count := -1
-	if n, ok := inputs["count"].(int); ok {
+	if n, ok := toInt(inputs["count"]); ok {
 		count = n
 	}
 
-	result := strings.Replace(str, old, new, count)
-	return map[string]interface{}{"result": result}
+	ignoreCase, _ := inputs["ignore_case"].(bool)
+	wholeWord, _ := inputs["whole_word"].(bool)
+	if !ignoreCase && !wholeWord || old == "" {
+		return map[string]interface{}{"result": strings.Replace(str, old, new, count)}
+	}
+
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(str) && count != 0; {
+		n := 0
+		if ignoreCase {
+			n = prefixFold(str[i:], old)
+		} else if strings.HasPrefix(str[i:], old) {
+			n = len(old)
+		}
+		if n > 0 && (!wholeWord || atBoundary(str, i, i+n)) {
+			b.WriteString(str[last:i])
+			b.WriteString(new)
+			i += n
+			last = i
+			count--
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(str[i:])
+		i += size
+	}
+	b.WriteString(str[last:])
+
+	return map[string]interface{}{"result": b.String()}
+}
+
+// prefixFold returns the number of bytes of s that match prefix ignoring
+// case, or 0 if s does not start with it.
+func prefixFold(s, prefix string) int {
+	i := 0
+	for _, pr := range prefix {
+		if i >= len(s) {
+			return 0
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != pr && unicode.ToLower(r) != unicode.ToLower(pr) && unicode.ToUpper(r) != unicode.ToUpper(pr) {
+			return 0
+		}
+		i += size
+	}
+	return i
+}
+
+// atBoundary reports whether s[start:end] is not joined to a word
+// character on either side.
+func atBoundary(s string, start, end int) bool {
+	if r, _ := utf8.DecodeLastRuneInString(s[:start]); start > 0 && isWord(r) {
+		return false
+	}
+	if r, _ := utf8.DecodeRuneInString(s[end:]); end < len(s) && isWord(r) {
+		return false
+	}
+	return true
+}
+
+func isWord(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
 }
@@ -0,0 +1,7 @@
+// Package string_replace_many provides factory for StringReplaceMany plugin.
+package string_replace_many
+
+// Create returns a new StringReplaceMany instance.
+func Create() *StringReplaceMany {
+	return NewStringReplaceMany()
+}
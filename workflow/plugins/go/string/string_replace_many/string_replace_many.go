@@ -0,0 +1,145 @@
+// Package string_replace_many provides a workflow plugin for applying several replacements at once.
+package string_replace_many
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// StringReplaceMany implements the NodeExecutor interface for multiple replacements.
+type StringReplaceMany struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringReplaceMany creates a new StringReplaceMany instance.
+func NewStringReplaceMany() *StringReplaceMany {
+	return &StringReplaceMany{
+		NodeType:    "string.replace_many",
+		Category:    "string",
+		Description: "Apply many replacements to a string in one pass",
+	}
+}
+
+// pair is one replacement.
+type pair struct {
+	old, new string
+}
+
+// Execute runs the plugin logic.
+// The string is scanned once, like Go's strings.Replacer: replaced text is
+// never matched again, so {"a": "b", "b": "a"} swaps the two letters. Where
+// several replacements match at the same position, the one listed first
+// wins; for a dictionary, whose keys have no order, the longest key wins.
+// Empty keys are ignored.
+// Inputs:
+//   - string: the string to search
+//   - replacements: dictionary of old to new strings, or a list of [old, new] pairs applied in order of priority
+//   - ignore_case: (optional) match case-insensitively (default: false)
+//
+// Returns:
+//   - result: the string with replacements applied
+//   - count: number of replacements made
+//   - error: set if string or replacements is missing or invalid
+func (p *StringReplaceMany) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": "", "count": 0, "error": fmt.Sprintf(format, args...)}
+	}
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return fail("string is required")
+	}
+
+	var pairs []pair
+	switch r := inputs["replacements"].(type) {
+	case map[string]interface{}:
+		for old, new := range r {
+			s, ok := new.(string)
+			if !ok {
+				return fail("replacement for %q must be a string", old)
+			}
+			pairs = append(pairs, pair{old, s})
+		}
+		sort.Slice(pairs, func(i, j int) bool {
+			if len(pairs[i].old) != len(pairs[j].old) {
+				return len(pairs[i].old) > len(pairs[j].old)
+			}
+			return pairs[i].old < pairs[j].old
+		})
+	case []interface{}:
+		for i, item := range r {
+			p, ok := item.([]interface{})
+			if !ok || len(p) != 2 {
+				return fail("replacements[%d] must be an [old, new] pair", i)
+			}
+			old, ok1 := p[0].(string)
+			new, ok2 := p[1].(string)
+			if !ok1 || !ok2 {
+				return fail("replacements[%d] must be a pair of strings", i)
+			}
+			pairs = append(pairs, pair{old, new})
+		}
+	default:
+		return fail("replacements is required")
+	}
+	ignoreCase, _ := inputs["ignore_case"].(bool)
+
+	prefixLen := prefixExact
+	if ignoreCase {
+		prefixLen = prefixFold
+	}
+
+	var b strings.Builder
+	count := 0
+	for i := 0; i < len(str); {
+		matched := false
+		for _, p := range pairs {
+			if p.old == "" {
+				continue
+			}
+			if n := prefixLen(str[i:], p.old); n > 0 {
+				b.WriteString(p.new)
+				i += n
+				count++
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			_, size := utf8.DecodeRuneInString(str[i:])
+			b.WriteString(str[i : i+size])
+			i += size
+		}
+	}
+
+	return map[string]interface{}{"result": b.String(), "count": count}
+}
+
+// prefixExact returns the length of prefix if s starts with it, or 0.
+func prefixExact(s, prefix string) int {
+	if strings.HasPrefix(s, prefix) {
+		return len(prefix)
+	}
+	return 0
+}
+
+// prefixFold returns the number of bytes of s that match prefix ignoring
+// case, or 0 if s does not start with it.
+func prefixFold(s, prefix string) int {
+	i := 0
+	for _, pr := range prefix {
+		if i >= len(s) {
+			return 0
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != pr && unicode.ToLower(r) != unicode.ToLower(pr) && unicode.ToUpper(r) != unicode.ToUpper(pr) {
+			return 0
+		}
+		i += size
+	}
+	return i
+}
@@ -0,0 +1,7 @@
+// Package string_reverse provides factory for StringReverse plugin.
+package string_reverse
+
+// Create returns a new StringReverse instance.
+func Create() *StringReverse {
+	return NewStringReverse()
+}
@@ -0,0 +1,49 @@
+// Package string_reverse provides a workflow plugin for reversing strings.
+package string_reverse
+
+import (
+	"strings"
+
+	"github.com/metabuilder/workflow-plugins-go/grapheme"
+)
+
+// StringReverse implements the NodeExecutor interface for reversing strings.
+type StringReverse struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringReverse creates a new StringReverse instance.
+func NewStringReverse() *StringReverse {
+	return &StringReverse{
+		NodeType:    "string.reverse",
+		Category:    "string",
+		Description: "Reverse a string by user-perceived characters",
+	}
+}
+
+// Execute runs the plugin logic.
+// Reverses grapheme clusters rather than bytes or runes, so accented
+// letters, flags and emoji sequences stay intact.
+// Inputs:
+//   - string: the string to reverse
+//
+// Returns:
+//   - result: the reversed string
+//   - error: set if string is missing
+func (p *StringReverse) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "string is required"}
+	}
+
+	clusters := grapheme.Split(str)
+	var b strings.Builder
+	b.Grow(len(str))
+	for i := len(clusters) - 1; i >= 0; i-- {
+		b.WriteString(clusters[i])
+	}
+
+	return map[string]interface{}{"result": b.String()}
+}
@@ -0,0 +1,7 @@
+// Package string_similarity provides factory for StringSimilarity plugin.
+package string_similarity
+
+// Create returns a new StringSimilarity instance.
+func Create() *StringSimilarity {
+	return NewStringSimilarity()
+}
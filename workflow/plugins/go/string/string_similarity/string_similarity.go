@@ -0,0 +1,154 @@
+// Package string_similarity provides a workflow plugin for fuzzy string comparison.
+package string_similarity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StringSimilarity implements the NodeExecutor interface for comparing strings.
+type StringSimilarity struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringSimilarity creates a new StringSimilarity instance.
+func NewStringSimilarity() *StringSimilarity {
+	return &StringSimilarity{
+		NodeType:    "string.similarity",
+		Category:    "string",
+		Description: "Compare two strings with edit distance and Jaro-Winkler similarity",
+	}
+}
+
+// maxLength bounds the length of each string in runes. Both measures take
+// time proportional to the product of the lengths.
+const maxLength = 10000
+
+// Execute runs the plugin logic.
+// Strings are compared by runes. The ratio is 1 - distance divided by the
+// length of the longer string, so identical strings score 1 and two empty
+// strings are identical. Jaro-Winkler favours strings sharing a prefix and
+// suits short values such as names. Strings longer than 10,000 characters
+// are refused.
+// Inputs:
+//   - a: the first string
+//   - b: the second string
+//   - ignore_case: (optional) compare case-insensitively (default: false)
+//
+// Returns:
+//   - distance: the Levenshtein edit distance
+//   - ratio: similarity from 0 to 1 based on the edit distance
+//   - jaro_winkler: Jaro-Winkler similarity from 0 to 1
+//   - error: set if a or b is missing or too long
+func (p *StringSimilarity) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	a, okA := inputs["a"].(string)
+	b, okB := inputs["b"].(string)
+	if !okA || !okB {
+		return map[string]interface{}{"distance": 0, "ratio": 0.0, "jaro_winkler": 0.0, "error": "a and b are required"}
+	}
+	if ignoreCase, _ := inputs["ignore_case"].(bool); ignoreCase {
+		a, b = strings.ToLower(a), strings.ToLower(b)
+	}
+	x, y := []rune(a), []rune(b)
+	if len(x) > maxLength || len(y) > maxLength {
+		return map[string]interface{}{"distance": 0, "ratio": 0.0, "jaro_winkler": 0.0, "error": fmt.Sprintf("a and b must be at most %d characters", maxLength)}
+	}
+
+	distance := levenshtein(x, y)
+	ratio := 1.0
+	if longest := max(len(x), len(y)); longest > 0 {
+		ratio = 1 - float64(distance)/float64(longest)
+	}
+
+	return map[string]interface{}{
+		"distance":     distance,
+		"ratio":        ratio,
+		"jaro_winkler": jaroWinkler(x, y),
+	}
+}
+
+// levenshtein returns the number of single-rune insertions, deletions and
+// substitutions needed to turn a into b.
+func levenshtein(a, b []rune) int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	// Keep one row of the table, sized by the shorter string
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			next := min(row[j]+1, row[j-1]+1, diag+cost)
+			diag = row[j]
+			row[j] = next
+		}
+	}
+	return row[len(b)]
+}
+
+// jaroWinkler returns the Jaro similarity of a and b, boosted by up to four
+// runes of common prefix.
+func jaroWinkler(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	window := max(len(a), len(b))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		lo, hi := max(0, i-window), min(len(b), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count matched runes that appear in a different order
+	transpositions := 0
+	j := 0
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(a), len(b)) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
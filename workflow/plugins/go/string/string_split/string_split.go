@@ -2,7 +2,10 @@
 package string_split
 
 import (
+	"sort"
 	"strings"
+
+	"github.com/metabuilder/workflow-plugins-go/grapheme"
 )
 
 // StringSplit implements the NodeExecutor interface for splitting strings.
@@ -22,6 +25,20 @@ func NewStringSplit() *StringSplit {
 }
 
 // Execute runs the plugin logic.
+// An empty separator splits into user-perceived characters (grapheme
+// clusters), so emoji and accented letters are kept whole. With
+// separators, the string is split at any of them, preferring the longest
+// where several match. With a limit, the last part holds the unsplit
+// remainder. Use regex.split for patterns.
+// Inputs:
+//   - string: the string to split
+//   - separator: (optional) the separator, empty splits into characters (default: "")
+//   - separators: (optional) list of separators to split at, instead of separator
+//   - limit: (optional) maximum number of parts (default: no limit)
+//   - drop_empty: (optional) leave out empty parts; they do not count toward limit (default: false)
+//
+// Returns:
+//   - result: list of parts
 func (p *StringSplit) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	str, ok := inputs["string"].(string)
 	if !ok {
@@ -32,16 +49,85 @@ func (p *StringSplit) Execute(inputs map[string]interface{}, runtime interface{}
 	if sep, ok := inputs["separator"].(string); ok {
 		separator = sep
 	}
+	var separators []string
+	if list, ok := inputs["separators"].([]interface{}); ok {
+		for _, s := range list {
+			if s, ok := s.(string); ok && s != "" {
+				separators = append(separators, s)
+			}
+		}
+	} else if separator != "" {
+		separators = []string{separator}
+	}
+
+	limit := -1
+	if n, ok := toInt(inputs["limit"]); ok && n > 0 {
+		limit = n
+	}
+	dropEmpty, _ := inputs["drop_empty"].(bool)
 
 	var result []string
-	if separator == "" {
+	if len(separators) == 0 {
 		// Split into characters
-		for _, r := range str {
-			result = append(result, string(r))
+		result = grapheme.Split(str)
+		if limit > 0 && len(result) > limit {
+			result = append(result[:limit-1], strings.Join(result[limit-1:], ""))
 		}
 	} else {
-		result = strings.Split(str, separator)
+		result = splitAny(str, separators, limit, dropEmpty)
+	}
+	if result == nil {
+		result = []string{}
 	}
 
 	return map[string]interface{}{"result": result}
 }
+
+// splitAny splits s at every occurrence of any of separators, into at most
+// limit parts when limit is positive. With dropEmpty, empty parts are left
+// out before they count toward the limit.
+func splitAny(s string, separators []string, limit int, dropEmpty bool) []string {
+	sort.SliceStable(separators, func(i, j int) bool { return len(separators[i]) > len(separators[j]) })
+
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); {
+		matched := ""
+		for _, sep := range separators {
+			if strings.HasPrefix(s[i:], sep) {
+				matched = sep
+				break
+			}
+		}
+		if matched == "" {
+			i++
+			continue
+		}
+		part := s[start:i]
+		if part != "" || !dropEmpty {
+			if limit > 0 && len(parts) == limit-1 {
+				break
+			}
+			parts = append(parts, part)
+		}
+		i += len(matched)
+		start = i
+	}
+	if rest := s[start:]; !dropEmpty || rest != "" {
+		parts = append(parts, rest)
+	}
+	return parts
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
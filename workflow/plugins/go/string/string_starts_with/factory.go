@@ -0,0 +1,7 @@
+// Package string_starts_with provides factory for StringStartsWith plugin.
+package string_starts_with
+
+// Create returns a new StringStartsWith instance.
+func Create() *StringStartsWith {
+	return NewStringStartsWith()
+}
@@ -0,0 +1,48 @@
+// Package string_starts_with provides a workflow plugin for checking string prefixes.
+package string_starts_with
+
+import (
+	"strings"
+)
+
+// StringStartsWith implements the NodeExecutor interface for checking string prefixes.
+type StringStartsWith struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringStartsWith creates a new StringStartsWith instance.
+func NewStringStartsWith() *StringStartsWith {
+	return &StringStartsWith{
+		NodeType:    "string.starts_with",
+		Category:    "string",
+		Description: "Check whether a string starts with a prefix",
+	}
+}
+
+// Execute runs the plugin logic.
+// Inputs:
+//   - string: the string to check
+//   - value: the prefix to check for
+//   - ignore_case: (optional) compare case-insensitively (default: false)
+//
+// Returns:
+//   - result: true if the check passes
+//   - error: set if string or value is missing
+func (p *StringStartsWith) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": false, "error": "string is required"}
+	}
+	value, ok := inputs["value"].(string)
+	if !ok {
+		return map[string]interface{}{"result": false, "error": "value is required"}
+	}
+
+	if ignoreCase, _ := inputs["ignore_case"].(bool); ignoreCase {
+		str, value = strings.ToLower(str), strings.ToLower(value)
+	}
+
+	return map[string]interface{}{"result": strings.HasPrefix(str, value)}
+}
@@ -0,0 +1,7 @@
+// Package string_stats provides factory for StringStats plugin.
+package string_stats
+
+// Create returns a new StringStats instance.
+func Create() *StringStats {
+	return NewStringStats()
+}
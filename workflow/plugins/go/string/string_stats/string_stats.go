@@ -0,0 +1,58 @@
+// Package string_stats provides a workflow plugin for counting words, lines and characters.
+package string_stats
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// StringStats implements the NodeExecutor interface for string statistics.
+type StringStats struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringStats creates a new StringStats instance.
+func NewStringStats() *StringStats {
+	return &StringStats{
+		NodeType:    "string.stats",
+		Category:    "string",
+		Description: "Count words, lines, runes and bytes in a string",
+	}
+}
+
+// Execute runs the plugin logic.
+// Words are runs of non-whitespace. Lines are separated by "\n" or "\r\n";
+// a trailing line break does not start another line, and an empty string
+// has no lines.
+// Inputs:
+//   - string: the string to measure
+//
+// Returns:
+//   - words: number of words
+//   - lines: number of lines
+//   - runes: number of Unicode code points
+//   - bytes: size in bytes of the UTF-8 encoding
+//   - error: set if string is missing
+func (p *StringStats) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"words": 0, "lines": 0, "runes": 0, "bytes": 0, "error": "string is required"}
+	}
+
+	lines := 0
+	if str != "" {
+		lines = strings.Count(str, "\n")
+		if !strings.HasSuffix(str, "\n") {
+			lines++
+		}
+	}
+
+	return map[string]interface{}{
+		"words": len(strings.Fields(str)),
+		"lines": lines,
+		"runes": utf8.RuneCountInString(str),
+		"bytes": len(str),
+	}
+}
@@ -0,0 +1,7 @@
+// Package string_strip_html provides factory for StringStripHTML plugin.
+package string_strip_html
+
+// Create returns a new StringStripHTML instance.
+func Create() *StringStripHTML {
+	return NewStringStripHTML()
+}
@@ -0,0 +1,218 @@
+// Package string_strip_html provides a workflow plugin for converting HTML to plain text.
+package string_strip_html
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// StringStripHTML implements the NodeExecutor interface for stripping HTML tags.
+type StringStripHTML struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringStripHTML creates a new StringStripHTML instance.
+func NewStringStripHTML() *StringStripHTML {
+	return &StringStripHTML{
+		NodeType:    "string.strip_html",
+		Category:    "string",
+		Description: "Remove HTML tags and decode entities to plain text",
+	}
+}
+
+// Execute runs the plugin logic.
+// Tags and comments are removed and the contents of script and style
+// elements dropped. Line breaks and block elements such as p, div and li
+// become line breaks. Tags in allow are kept, without their attributes;
+// the result is then still HTML, so text keeps its entities escaped.
+// Otherwise entities are decoded.
+// Inputs:
+//   - string: the HTML to strip
+//   - allow: (optional) list of tag names to keep, e.g. ["b", "i", "a"] (default: none)
+//   - collapse_whitespace: (optional) collapse runs of spaces and blank lines as a browser would (default: true)
+//
+// Returns:
+//   - result: the text
+//   - error: set if string is missing
+func (p *StringStripHTML) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "string is required"}
+	}
+
+	allow := map[string]bool{}
+	if list, ok := inputs["allow"].([]interface{}); ok {
+		for _, t := range list {
+			if t, ok := t.(string); ok {
+				allow[strings.ToLower(strings.Trim(t, "<>/ "))] = true
+			}
+		}
+	}
+	collapse := true
+	if c, ok := inputs["collapse_whitespace"].(bool); ok {
+		collapse = c
+	}
+
+	result := strip(str, allow)
+	if collapse {
+		result = collapseWhitespace(result)
+	}
+
+	return map[string]interface{}{"result": result}
+}
+
+// blockTags start or end a line in the text.
+var blockTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true,
+	"dd": true, "div": true, "dl": true, "dt": true, "figcaption": true, "figure": true,
+	"footer": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "main": true, "nav": true, "ol": true,
+	"p": true, "pre": true, "section": true, "table": true, "tr": true, "ul": true,
+}
+
+// rawTags hold content that is not text.
+var rawTags = map[string]bool{"script": true, "style": true, "template": true}
+
+// strip removes markup from s, keeping allowed tags.
+func strip(s string, allow map[string]bool) string {
+	var b strings.Builder
+	text := func(t string) {
+		t = html.UnescapeString(t)
+		if len(allow) > 0 {
+			t = html.EscapeString(t)
+		}
+		b.WriteString(t)
+	}
+
+	for {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			text(s)
+			break
+		}
+		text(s[:i])
+		s = s[i:]
+
+		switch {
+		case strings.HasPrefix(s, "<!--"):
+			end := strings.Index(s[4:], "-->")
+			if end < 0 {
+				return b.String()
+			}
+			s = s[4+end+3:]
+			continue
+		case len(s) > 1 && (s[1] == '!' || s[1] == '?'):
+			// Doctype or processing instruction
+			end := strings.IndexByte(s, '>')
+			if end < 0 {
+				return b.String()
+			}
+			s = s[end+1:]
+			continue
+		}
+
+		name, closing, n := parseTag(s)
+		if n == 0 {
+			// A lone "<" is text
+			text("<")
+			s = s[1:]
+			continue
+		}
+		s = s[n:]
+
+		if !closing && rawTags[name] {
+			end := indexFold(s, "</"+name)
+			if end < 0 {
+				return b.String()
+			}
+			s = s[end:]
+			if gt := strings.IndexByte(s, '>'); gt >= 0 {
+				s = s[gt+1:]
+			} else {
+				s = ""
+			}
+			continue
+		}
+		switch {
+		case allow[name]:
+			if closing {
+				b.WriteString("</" + name + ">")
+			} else {
+				b.WriteString("<" + name + ">")
+			}
+		case blockTags[name]:
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// parseTag reads the tag at the start of s, returning its lower-case name,
+// whether it is a closing tag and its length in bytes; the length is zero
+// when s does not start with a tag.
+func parseTag(s string) (name string, closing bool, n int) {
+	i := 1
+	if i < len(s) && s[i] == '/' {
+		closing = true
+		i++
+	}
+	start := i
+	for i < len(s) && (isLetter(s[i]) || i > start && (s[i] >= '0' && s[i] <= '9' || s[i] == '-')) {
+		i++
+	}
+	if i == start {
+		return "", false, 0
+	}
+	name = strings.ToLower(s[start:i])
+
+	// Find the closing ">", skipping quoted attribute values
+	var quote byte
+	for ; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return name, closing, i + 1
+		}
+	}
+	return name, closing, len(s)
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// indexFold returns the index of the first case-insensitive match of the
+// ASCII string sub in s, or -1.
+func indexFold(s, sub string) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+var (
+	spaces     = regexp.MustCompile(`[ \t\f\r\x{00a0}]+`)
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// collapseWhitespace joins runs of spaces, trims each line and allows at
+// most one blank line in a row.
+func collapseWhitespace(s string) string {
+	s = spaces.ReplaceAllString(s, " ")
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	s = blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(s)
+}
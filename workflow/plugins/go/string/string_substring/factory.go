@@ -0,0 +1,7 @@
+// Package string_substring provides factory for StringSubstring plugin.
+package string_substring
+
+// Create returns a new StringSubstring instance.
+func Create() *StringSubstring {
+	return NewStringSubstring()
+}
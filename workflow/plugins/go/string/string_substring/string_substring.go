@@ -0,0 +1,84 @@
+// Package string_substring provides a workflow plugin for extracting substrings.
+package string_substring
+
+import (
+	"github.com/metabuilder/workflow-plugins-go/intconv"
+)
+
+// StringSubstring implements the NodeExecutor interface for extracting substrings.
+type StringSubstring struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringSubstring creates a new StringSubstring instance.
+func NewStringSubstring() *StringSubstring {
+	return &StringSubstring{
+		NodeType:    "string.substring",
+		Category:    "string",
+		Description: "Extract part of a string by character position",
+	}
+}
+
+// Execute runs the plugin logic.
+// Positions count characters (runes), not bytes, so multi-byte text is
+// never cut in the middle of a character. Like list.slice, negative
+// positions count from the end and out-of-range positions are clamped.
+// Inputs:
+//   - string: the string to extract from
+//   - start: (optional) starting position (default: 0)
+//   - end: (optional) ending position, exclusive (default: end of string)
+//   - length: (optional) number of characters to take from start, instead of end
+//
+// Returns:
+//   - result: the extracted substring
+//   - error: set if string is missing or length is negative
+func (p *StringSubstring) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "string is required"}
+	}
+	runes := []rune(str)
+
+	start := 0
+	if s, ok := intconv.Int(inputs["start"]); ok {
+		start = s
+	}
+	if start < 0 {
+		start = len(runes) + start
+	}
+	start = clamp(start, len(runes))
+
+	end := len(runes)
+	if n, ok := intconv.Int(inputs["length"]); ok {
+		if n < 0 {
+			return map[string]interface{}{"result": "", "error": "length must not be negative"}
+		}
+		end = len(runes)
+		if n < end-start {
+			end = start + n
+		}
+	} else if e, ok := intconv.Int(inputs["end"]); ok {
+		end = e
+		if end < 0 {
+			end = len(runes) + end
+		}
+	}
+	end = clamp(end, len(runes))
+	if start > end {
+		start = end
+	}
+
+	return map[string]interface{}{"result": string(runes[start:end])}
+}
+
+func clamp(i, length int) int {
+	if i < 0 {
+		return 0
+	}
+	if i > length {
+		return length
+	}
+	return i
+}
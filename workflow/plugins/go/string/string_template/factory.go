@@ -0,0 +1,7 @@
+// Package string_template provides factory for StringTemplate plugin.
+package string_template
+
+// Create returns a new StringTemplate instance.
+func Create() *StringTemplate {
+	return NewStringTemplate()
+}
@@ -0,0 +1,184 @@
+// Package string_template provides a workflow plugin for rendering Go templates.
+package string_template
+
+import (
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// StringTemplate implements the NodeExecutor interface for rendering Go templates.
+type StringTemplate struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringTemplate creates a new StringTemplate instance.
+func NewStringTemplate() *StringTemplate {
+	return &StringTemplate{
+		NodeType:    "string.template",
+		Category:    "string",
+		Description: "Render a Go text/template",
+	}
+}
+
+// maxLength bounds the size of the rendered text in bytes.
+const maxLength = 10 << 20
+
+// errTooLong is returned when the rendered text would exceed maxLength.
+var errTooLong = fmt.Errorf("result would be more than the limit of %d bytes", maxLength)
+
+// Runtime interface for accessing workflow store.
+type Runtime interface {
+	GetStore() map[string]interface{}
+}
+
+// funcs are the functions available to templates, in addition to the
+// text/template builtins:
+//   - upper, lower, trim: string case and whitespace
+//   - default: {{ .name | default "friend" }} uses the fallback when the value is empty
+//   - join: {{ .tags | join ", " }} joins a list, formatting elements like convert.to_string
+//   - json: {{ json .user }} encodes a value as JSON
+var funcs = map[string]interface{}{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"default": func(fallback, value interface{}) interface{} {
+		if empty(value) {
+			return fallback
+		}
+		return value
+	},
+	"join": func(sep string, list []interface{}) string {
+		parts := make([]string, len(list))
+		for i, item := range list {
+			parts[i] = stringify(item)
+		}
+		return strings.Join(parts, sep)
+	},
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// Execute runs the plugin logic.
+// Renders template with data as the dot value, e.g.
+// "Hello {{ .user.name | default \"there\" }}". See the funcs variable for
+// the functions available besides the text/template builtins. With html,
+// values are escaped for the context they appear in, using html/template.
+// Rendering stops with an error once the result passes 10 MiB.
+// Inputs:
+//   - template: the template text
+//   - data: (optional) dictionary the template is rendered against
+//   - use_store: (optional) render against the workflow store, with data taking precedence (default: false)
+//   - html: (optional) escape output for HTML (default: false)
+//   - strict: (optional) fail on missing keys instead of printing "<no value>" (default: false)
+//
+// Returns:
+//   - result: the rendered text
+//   - error: set if the template cannot be parsed or executed, or the result would be too long
+func (p *StringTemplate) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	text, ok := inputs["template"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "template is required"}
+	}
+
+	data := map[string]interface{}{}
+	if useStore, _ := inputs["use_store"].(bool); useStore {
+		for k, v := range storeOf(runtime) {
+			data[k] = v
+		}
+	}
+	if d, ok := inputs["data"].(map[string]interface{}); ok {
+		for k, v := range d {
+			data[k] = v
+		}
+	}
+
+	missingKey := "missingkey=default"
+	if strict, _ := inputs["strict"].(bool); strict {
+		missingKey = "missingkey=error"
+	}
+
+	var b limitedBuilder
+	var err error
+	if html, _ := inputs["html"].(bool); html {
+		var t *htmltemplate.Template
+		if t, err = htmltemplate.New("template").Funcs(funcs).Option(missingKey).Parse(text); err == nil {
+			err = t.Execute(&b, data)
+		}
+	} else {
+		var t *template.Template
+		if t, err = template.New("template").Funcs(funcs).Option(missingKey).Parse(text); err == nil {
+			err = t.Execute(&b, data)
+		}
+	}
+	if err != nil {
+		return map[string]interface{}{"result": "", "error": err.Error()}
+	}
+
+	return map[string]interface{}{"result": b.String()}
+}
+
+// limitedBuilder is a writer that refuses to grow past maxLength,
+// so a short template with a large range cannot exhaust memory.
+type limitedBuilder struct {
+	b strings.Builder
+}
+
+func (l *limitedBuilder) Write(p []byte) (int, error) {
+	if l.b.Len()+len(p) > maxLength {
+		return 0, errTooLong
+	}
+	return l.b.Write(p)
+}
+
+func (l *limitedBuilder) String() string {
+	return l.b.String()
+}
+
+// empty reports whether v is null, false, zero or an empty string or
+// collection.
+func empty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return rv.Len() == 0
+	}
+	return rv.IsZero()
+}
+
+// stringify formats a value like convert.to_string.
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		if data, err := json.Marshal(v); err == nil {
+			return string(data)
+		}
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func storeOf(runtime interface{}) map[string]interface{} {
+	if r, ok := runtime.(Runtime); ok {
+		return r.GetStore()
+	}
+	if r, ok := runtime.(map[string]interface{}); ok {
+		if s, ok := r["Store"].(map[string]interface{}); ok {
+			return s
+		}
+	}
+	return nil
+}
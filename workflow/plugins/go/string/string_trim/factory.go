@@ -0,0 +1,7 @@
+// Package string_trim provides factory for StringTrim plugin.
+package string_trim
+
+// Create returns a new StringTrim instance.
+func Create() *StringTrim {
+	return NewStringTrim()
+}
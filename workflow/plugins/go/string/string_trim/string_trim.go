@@ -0,0 +1,75 @@
+// Package string_trim provides a workflow plugin for trimming strings.
+package string_trim
+
+import (
+	"strings"
+	"unicode"
+)
+
+// StringTrim implements the NodeExecutor interface for trimming strings.
+type StringTrim struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringTrim creates a new StringTrim instance.
+func NewStringTrim() *StringTrim {
+	return &StringTrim{
+		NodeType:    "string.trim",
+		Category:    "string",
+		Description: "Trim whitespace, characters or a prefix/suffix from a string",
+	}
+}
+
+// Execute runs the plugin logic.
+// By default removes Unicode whitespace (including non-breaking and
+// ideographic spaces) from both ends. With cutset, any of its characters
+// are removed instead. When prefix or suffix is set, those are removed
+// once, if present, and no characters are trimmed (side does not apply).
+// Inputs:
+//   - string: the string to trim
+//   - side: (optional) "both", "left" or "right" (default: "both")
+//   - cutset: (optional) characters to remove instead of whitespace
+//   - prefix: (optional) exact prefix to remove
+//   - suffix: (optional) exact suffix to remove
+//
+// Returns:
+//   - result: the trimmed string
+//   - error: set if string is missing or side is invalid
+func (p *StringTrim) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "string is required"}
+	}
+
+	left, right := true, true
+	switch side, _ := inputs["side"].(string); side {
+	case "", "both":
+	case "left":
+		right = false
+	case "right":
+		left = false
+	default:
+		return map[string]interface{}{"result": str, "error": "side must be \"both\", \"left\" or \"right\""}
+	}
+
+	prefix, hasPrefix := inputs["prefix"].(string)
+	suffix, hasSuffix := inputs["suffix"].(string)
+	if hasPrefix || hasSuffix {
+		return map[string]interface{}{"result": strings.TrimSuffix(strings.TrimPrefix(str, prefix), suffix)}
+	}
+
+	trim := unicode.IsSpace
+	if cutset, ok := inputs["cutset"].(string); ok {
+		trim = func(r rune) bool { return strings.ContainsRune(cutset, r) }
+	}
+	if left {
+		str = strings.TrimLeftFunc(str, trim)
+	}
+	if right {
+		str = strings.TrimRightFunc(str, trim)
+	}
+
+	return map[string]interface{}{"result": str}
+}
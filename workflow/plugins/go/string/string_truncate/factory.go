@@ -0,0 +1,7 @@
+// Package string_truncate provides factory for StringTruncate plugin.
+package string_truncate
+
+// Create returns a new StringTruncate instance.
+func Create() *StringTruncate {
+	return NewStringTruncate()
+}
@@ -0,0 +1,105 @@
+// Package string_truncate provides a workflow plugin for truncating strings.
+package string_truncate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/metabuilder/workflow-plugins-go/intconv"
+)
+
+// StringTruncate implements the NodeExecutor interface for truncating strings.
+type StringTruncate struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringTruncate creates a new StringTruncate instance.
+func NewStringTruncate() *StringTruncate {
+	return &StringTruncate{
+		NodeType:    "string.truncate",
+		Category:    "string",
+		Description: "Shorten a string to a maximum length",
+	}
+}
+
+// Execute runs the plugin logic.
+// Strings within length are returned unchanged. Otherwise the string is
+// cut so that, with the ellipsis appended, it fits in length. In bytes
+// mode the cut never splits a UTF-8 character. With word_boundary the cut
+// moves back to the end of the last whole word, unless that would leave
+// nothing.
+// Inputs:
+//   - string: the string to truncate
+//   - length: the maximum length, including the ellipsis
+//   - unit: (optional) "runes" or "bytes" (default: "runes")
+//   - ellipsis: (optional) appended when the string is cut, e.g. "…" (default: "")
+//   - word_boundary: (optional) avoid cutting inside a word (default: false)
+//
+// Returns:
+//   - result: the truncated string
+//   - truncated: true if the string was cut
+//   - error: set if string or length is missing, length is negative or unit is invalid
+func (p *StringTruncate) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	fail := func(format string, args ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"result": "", "truncated": false, "error": fmt.Sprintf(format, args...)}
+	}
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return fail("string is required")
+	}
+	length, ok := intconv.Int(inputs["length"])
+	if !ok {
+		return fail("length is required")
+	}
+	if length < 0 {
+		return fail("length must not be negative")
+	}
+	ellipsis, _ := inputs["ellipsis"].(string)
+
+	measure := utf8.RuneCountInString
+	switch unit, _ := inputs["unit"].(string); unit {
+	case "", "runes":
+	case "bytes":
+		measure = func(s string) int { return len(s) }
+	default:
+		return fail("unit must be \"runes\" or \"bytes\"")
+	}
+
+	if measure(str) <= length {
+		return map[string]interface{}{"result": str, "truncated": false}
+	}
+
+	// Keep as many whole characters as fit next to the ellipsis
+	room := length - measure(ellipsis)
+	if room < 0 {
+		room, ellipsis = length, ""
+	}
+	cut, used := 0, 0
+	for _, r := range str {
+		size := measure(string(r))
+		if used+size > room {
+			break
+		}
+		used += size
+		cut += utf8.RuneLen(r)
+	}
+
+	if wordBoundary, _ := inputs["word_boundary"].(bool); wordBoundary {
+		next, _ := utf8.DecodeRuneInString(str[cut:])
+		if !unicode.IsSpace(next) {
+			// The cut is inside a word: drop its beginning
+			if i := strings.LastIndexFunc(str[:cut], unicode.IsSpace); i > 0 {
+				cut = i
+			}
+		}
+		if trimmed := strings.TrimRightFunc(str[:cut], unicode.IsSpace); trimmed != "" {
+			cut = len(trimmed)
+		}
+	}
+
+	return map[string]interface{}{"result": str[:cut] + ellipsis, "truncated": true}
+}
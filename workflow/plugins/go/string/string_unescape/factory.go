@@ -0,0 +1,7 @@
+// Package string_unescape provides factory for StringUnescape plugin.
+package string_unescape
+
+// Create returns a new StringUnescape instance.
+func Create() *StringUnescape {
+	return NewStringUnescape()
+}
@@ -0,0 +1,109 @@
+// Package string_unescape provides a workflow plugin for unescaping strings.
+package string_unescape
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+)
+
+// StringUnescape implements the NodeExecutor interface for unescaping strings.
+type StringUnescape struct {
+	NodeType    string
+	Category    string
+	Description string
+}
+
+// NewStringUnescape creates a new StringUnescape instance.
+func NewStringUnescape() *StringUnescape {
+	return &StringUnescape{
+		NodeType:    "string.unescape",
+		Category:    "string",
+		Description: "Reverse HTML, XML, JSON, URL or shell escaping",
+	}
+}
+
+// Execute runs the plugin logic.
+// Reverses the modes of string.escape. html and xml decode named and
+// numeric character references; shell decodes one word with single
+// quotes, double quotes and backslashes, without expanding variables.
+// Inputs:
+//   - string: the string to unescape
+//   - mode: html, xml, json, url, url_path or shell
+//
+// Returns:
+//   - result: the unescaped string
+//   - error: set if string is missing, mode is unknown or the string is not validly escaped
+func (p *StringUnescape) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
+	str, ok := inputs["string"].(string)
+	if !ok {
+		return map[string]interface{}{"result": "", "error": "string is required"}
+	}
+
+	var result string
+	var err error
+	switch mode, _ := inputs["mode"].(string); mode {
+	case "html", "xml":
+		result = html.UnescapeString(str)
+	case "json":
+		if err = json.Unmarshal([]byte(`"`+str+`"`), &result); err != nil {
+			err = fmt.Errorf("invalid JSON string contents: %v", err)
+		}
+	case "url":
+		result, err = url.QueryUnescape(str)
+	case "url_path":
+		result, err = url.PathUnescape(str)
+	case "shell":
+		result, err = shellUnquote(str)
+	default:
+		err = fmt.Errorf("unknown mode %q (want html, xml, json, url, url_path or shell)", mode)
+	}
+	if err != nil {
+		return map[string]interface{}{"result": "", "error": err.Error()}
+	}
+
+	return map[string]interface{}{"result": result}
+}
+
+// shellUnquote decodes a POSIX shell word.
+func shellUnquote(s string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\'':
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated single quote")
+			}
+			b.WriteString(s[i+1 : i+1+end])
+			i += end + 1
+		case '"':
+			i++
+			for ; i < len(s) && s[i] != '"'; i++ {
+				// Inside double quotes a backslash only escapes $ ` " \ and newline
+				if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("$`\"\\\n", s[i+1]) >= 0 {
+					i++
+					if s[i] == '\n' {
+						continue
+					}
+				}
+				b.WriteByte(s[i])
+			}
+			if i >= len(s) {
+				return "", fmt.Errorf("unterminated double quote")
+			}
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				if s[i] != '\n' {
+					b.WriteByte(s[i])
+				}
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), nil
+}
@@ -22,6 +22,11 @@ func NewStringUpper() *StringUpper {
 }
 
 // Execute runs the plugin logic.
+// Inputs:
+//   - string: the string to convert
+//
+// Returns:
+//   - result: the uppercased string
 func (p *StringUpper) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	str, ok := inputs["string"].(string)
 	if !ok {
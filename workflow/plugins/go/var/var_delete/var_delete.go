@@ -24,6 +24,12 @@ type Runtime interface {
 
 // Execute runs the plugin logic.
 // Removes a variable from the workflow store.
+// Inputs:
+//   - key: the variable name
+//
+// Returns:
+//   - success: whether the store was available
+//   - existed: whether the variable was set before deletion
 func (p *VarDelete) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	key, ok := inputs["key"].(string)
 	if !ok {
@@ -24,6 +24,13 @@ type Runtime interface {
 
 // Execute runs the plugin logic.
 // Retrieves a variable from the workflow store.
+// Inputs:
+//   - key: the variable name
+//   - default: (optional) value returned if the variable is not set
+//
+// Returns:
+//   - result: the variable value or default
+//   - exists: whether the variable was set
 func (p *VarGet) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	key, ok := inputs["key"].(string)
 	if !ok {
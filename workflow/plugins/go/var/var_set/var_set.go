@@ -24,6 +24,13 @@ type Runtime interface {
 
 // Execute runs the plugin logic.
 // Stores a variable in the workflow store.
+// Inputs:
+//   - key: the variable name
+//   - value: the value to store
+//
+// Returns:
+//   - success: whether the variable was stored
+//   - key: the variable name
 func (p *VarSet) Execute(inputs map[string]interface{}, runtime interface{}) map[string]interface{} {
 	key, ok := inputs["key"].(string)
 	if !ok {
@@ -0,0 +1,137 @@
+"""
+Plugin host for the Go workflow runtime.
+
+Run from the repository root with ``python -m workflow.plugins.python.bridge``.
+The Go side (workflow/plugins/go/bridge) talks to this process over stdin and
+stdout using length-prefixed JSON messages: each message is a JSON object
+preceded by its length as a 4-byte big-endian unsigned integer.
+
+Methods:
+    list:    returns [{"node_type", "category", "description"}, ...]
+    execute: params {"node_type", "inputs", "store"};
+             returns {"outputs", "store"}
+    ping:    returns "pong"
+
+Plugins are discovered through the ``create()`` function of each plugin's
+factory module. Plugins whose dependencies are missing are skipped with a
+message on stderr.
+"""
+
+import importlib
+import json
+import logging
+import struct
+import sys
+from pathlib import Path
+from typing import Any, Dict, Optional
+
+from .base import NodeExecutor
+
+PACKAGE = "workflow.plugins.python"
+
+logger = logging.getLogger("metabuilder.bridge")
+
+
+class BridgeRuntime:
+    """Runtime passed to plugins.
+
+    The store is replaced by the Go runtime's store on every call; the context
+    lives for the whole host process.
+    """
+
+    def __init__(self):
+        self.store: Dict[str, Any] = {}
+        self.context: Dict[str, Any] = {}
+        self.logger = logger
+
+
+def discover(package_path: Optional[Path] = None) -> Dict[str, NodeExecutor]:
+    """Create an executor for every plugin with a factory module."""
+    if package_path is None:
+        package_path = Path(__file__).parent
+
+    executors: Dict[str, NodeExecutor] = {}
+    for factory_file in sorted(package_path.glob("*/*/factory.py")):
+        plugin_dir = factory_file.parent
+        module_name = f"{PACKAGE}.{plugin_dir.parent.name}.{plugin_dir.name}.factory"
+        try:
+            executor = importlib.import_module(module_name).create()
+        except Exception as exc:  # missing optional dependencies, syntax errors
+            logger.warning("skipping %s: %s", module_name, exc)
+            continue
+        if executor.node_type:
+            executors[executor.node_type] = executor
+    return executors
+
+
+def read_message(stream) -> Optional[Dict[str, Any]]:
+    """Read one length-prefixed JSON message, or None at end of input."""
+    header = stream.read(4)
+    if len(header) < 4:
+        return None
+    (length,) = struct.unpack(">I", header)
+    return json.loads(stream.read(length))
+
+
+def write_message(stream, message: Dict[str, Any]) -> None:
+    """Write one length-prefixed JSON message."""
+    data = json.dumps(message, default=str).encode("utf-8")
+    stream.write(struct.pack(">I", len(data)))
+    stream.write(data)
+    stream.flush()
+
+
+def handle(executors: Dict[str, NodeExecutor], runtime: BridgeRuntime,
+           method: str, params: Dict[str, Any]) -> Any:
+    """Answer a single request."""
+    if method == "ping":
+        return "pong"
+    if method == "list":
+        return [
+            {
+                "node_type": e.node_type,
+                "category": e.category,
+                "description": e.description,
+            }
+            for e in executors.values()
+        ]
+    if method == "execute":
+        node_type = params.get("node_type")
+        executor = executors.get(node_type)
+        if executor is None:
+            raise ValueError(f"Unknown plugin: {node_type}")
+        runtime.store = params.get("store") or {}
+        try:
+            outputs = executor.execute(params.get("inputs") or {}, runtime)
+        except Exception as exc:
+            outputs = {"error": str(exc)}
+        return {"outputs": outputs, "store": runtime.store}
+    raise ValueError(f"Unknown method: {method}")
+
+
+def main() -> None:
+    logging.basicConfig(stream=sys.stderr, level=logging.INFO)
+
+    # Keep the protocol stream to ourselves: anything plugins print goes to stderr
+    stdin = sys.stdin.buffer
+    stdout = sys.stdout.buffer
+    sys.stdout = sys.stderr
+
+    executors = discover()
+    runtime = BridgeRuntime()
+    while True:
+        request = read_message(stdin)
+        if request is None:
+            break
+        response: Dict[str, Any] = {"id": request.get("id")}
+        try:
+            response["result"] = handle(
+                executors, runtime, request.get("method", ""), request.get("params") or {}
+            )
+        except Exception as exc:
+            response["error"] = str(exc) or type(exc).__name__
+        write_message(stdout, response)
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,159 @@
+#!/usr/bin/env node
+"use strict";
+/**
+ * Plugin host for the Go workflow runtime.
+ *
+ * Run with `node workflow/plugins/ts/bridge.js [module ...]`. Without module
+ * arguments the compiled category modules (`<category>/src/index.js`) next to
+ * this file are loaded. Every export ending in `PluginClasses` maps node types
+ * to plugin classes; each class is instantiated once.
+ *
+ * The Go side (workflow/plugins/go/bridge) talks to this process over stdin
+ * and stdout using length-prefixed JSON messages: each message is a JSON
+ * object preceded by its length as a 4-byte big-endian unsigned integer.
+ *
+ * Methods:
+ *   list:    returns [{node_type, category, description}, ...]
+ *   execute: params {node_type, inputs, store}; returns {outputs, store}
+ *   ping:    returns "pong"
+ *
+ * Go inputs become the node's `parameters` and the Go store becomes
+ * `state.variables`, which is where the var plugins keep their values.
+ */
+const fs = require("fs");
+const path = require("path");
+
+// Anything plugins log goes to stderr so the protocol stream stays clean.
+console.log = console.info = console.debug = console.error;
+
+/**
+ * Load plugin executors from compiled modules. Modules that fail to load
+ * (for example because the TypeScript build has not been run) are skipped.
+ */
+function discover(modules) {
+  if (modules.length === 0) {
+    modules = fs
+      .readdirSync(__dirname, { withFileTypes: true })
+      .filter((entry) => entry.isDirectory())
+      .map((entry) => path.join(__dirname, entry.name, "src", "index.js"))
+      .filter((file) => fs.existsSync(file));
+  }
+
+  const executors = new Map();
+  for (const file of modules) {
+    let exported;
+    try {
+      exported = require(path.resolve(file));
+    } catch (err) {
+      console.error(`skipping ${file}: ${err.message.split("\n")[0]}`);
+      continue;
+    }
+    for (const [name, classes] of Object.entries(exported)) {
+      if (!name.endsWith("PluginClasses") || typeof classes !== "object") {
+        continue;
+      }
+      for (const PluginClass of Object.values(classes)) {
+        const executor = new PluginClass();
+        if (executor.nodeType) {
+          executors.set(executor.nodeType, executor);
+        }
+      }
+    }
+  }
+  return executors;
+}
+
+/** Answer a single request. */
+async function handle(executors, method, params) {
+  switch (method) {
+    case "ping":
+      return "pong";
+    case "list":
+      return Array.from(executors.values(), (e) => ({
+        node_type: e.nodeType,
+        category: e.category,
+        description: e.description,
+      }));
+    case "execute": {
+      const executor = executors.get(params.node_type);
+      if (!executor) {
+        throw new Error(`Unknown plugin: ${params.node_type}`);
+      }
+      const variables = params.store || {};
+      const inputs = {
+        node: {
+          id: params.node_type,
+          name: params.node_type,
+          type: params.node_type,
+          nodeType: params.node_type,
+          parameters: params.inputs || {},
+        },
+        context: {
+          executionId: "",
+          tenantId: "",
+          userId: "",
+          triggerData: {},
+          variables,
+        },
+        state: { variables },
+      };
+      let outputs;
+      try {
+        outputs = await executor.execute(inputs, { logger: console });
+      } catch (err) {
+        outputs = { error: err instanceof Error ? err.message : String(err) };
+      }
+      return { outputs: outputs || {}, store: inputs.state.variables };
+    }
+    default:
+      throw new Error(`Unknown method: ${method}`);
+  }
+}
+
+function writeMessage(message) {
+  const data = Buffer.from(JSON.stringify(message), "utf8");
+  const header = Buffer.alloc(4);
+  header.writeUInt32BE(data.length);
+  process.stdout.write(Buffer.concat([header, data]));
+}
+
+async function main() {
+  const executors = discover(process.argv.slice(2));
+
+  // Requests are handled one at a time, in arrival order.
+  let buffered = Buffer.alloc(0);
+  let queue = Promise.resolve();
+  process.stdin.on("data", (chunk) => {
+    buffered = Buffer.concat([buffered, chunk]);
+    while (buffered.length >= 4) {
+      const length = buffered.readUInt32BE(0);
+      if (buffered.length < 4 + length) {
+        break;
+      }
+      const body = buffered.subarray(4, 4 + length).toString("utf8");
+      buffered = buffered.subarray(4 + length);
+
+      queue = queue.then(async () => {
+        let request;
+        try {
+          request = JSON.parse(body);
+        } catch (err) {
+          writeMessage({ id: null, error: `invalid message: ${err.message}` });
+          return;
+        }
+        const response = { id: request.id };
+        try {
+          response.result = await handle(executors, request.method, request.params || {});
+        } catch (err) {
+          response.error = err instanceof Error ? err.message : String(err);
+        }
+        writeMessage(response);
+      });
+    }
+  });
+  process.stdin.on("end", () => {
+    queue.then(() => process.exit(0));
+  });
+}
+
+main();